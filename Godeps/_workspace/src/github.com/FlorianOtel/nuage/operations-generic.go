@@ -11,6 +11,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"strings"
+	"time"
 )
 
 ////////
@@ -67,6 +70,24 @@ func CreateEntity(c *Connection, entity string, payload []byte) ([]byte, error)
 	return reply, nil
 }
 
+// Update Entity ("PUT"). Up to the caller to encode a valid "payload []byte" and select an appropriate API endpoint -- e.g. "vports/<ID>" or, for member assignment, "policygroups/<ID>/vports" (payload being the list of member IDs)
+func UpdateEntity(c *Connection, endpoint string, payload []byte) ([]byte, error) {
+	reply, statuscode, err := nuagetransaction(c, "PUT", c.Url+"/nuage/api/"+c.Apivers+"/"+endpoint, payload)
+
+	if err != nil {
+		log.Debugf("Nuage UPDATE entity: Unable to update entity. Error: %s", err)
+		return nil, err
+	}
+
+	if statuscode != 200 && statuscode != 204 {
+		log.Debugf("Nuage UPDATE entity: Unable to update entity. HTTP status code: %d", statuscode)
+		err = fmt.Errorf("HTTP status code: %d", statuscode)
+		return nil, err
+	}
+
+	return reply, nil
+}
+
 // Delete Entity. Up to the caller to provide a correct ID for the given API entity -- e.g. "enterprises"
 func DeleteEntity(c *Connection, entity string, id string) ([]byte, error) {
 	reply, statuscode, err := nuagetransaction(c, "DELETE", c.Url+"/nuage/api/"+c.Apivers+"/"+entity+"/"+id, []byte(""))
@@ -96,6 +117,119 @@ Reeval:
 	return reply, err
 }
 
+////////
+//////// Typed operations: JSON encoding / decoding on top of the generic operations above, shared by the API version specific packages.
+//////// "obj" is a pointer to an entity of one of those packages (e.g. "*nuage_v4_0.Enterprise") and "label" (e.g. "Enterprise") is used for logging and errors.
+//////// The API version is that of the connection (c.Apivers).
+////////
+
+// GET "<entity>/<id>" and decode it into "obj"
+func GetObject(c *Connection, label, entity, id string, obj interface{}) error {
+	if id == "" {
+		err := fmt.Errorf("%s Get: Empty ID, nothing to do", label)
+		return err
+	}
+
+	reply, err := GetEntity(c, entity+"/"+id)
+
+	if err != nil {
+		log.Debugf("%s Get: Unable to find %s with ID: [%s] . Error: %s ", label, label, id, err)
+		return err
+	}
+
+	err = decodefirst(reply, obj)
+
+	if err != nil {
+		log.Debugf("%s Get: Unable to decode JSON payload: %s ", label, err)
+		return err
+	}
+
+	log.Debugf("%s Get: Found %s with ID: [%s]", label, label, id)
+	return nil
+}
+
+// POST "obj" to "endpoint" (e.g. "enterprises" or "zones/<ID>/subnets") and decode the created entity back into it. Up to the caller to validate "obj"
+func CreateObject(c *Connection, label, endpoint string, obj interface{}) error {
+	jsonobj, _ := json.MarshalIndent(obj, "", "\t")
+	reply, err := CreateEntity(c, endpoint, jsonobj)
+
+	if err != nil {
+		log.Debugf("%s Create: Unable to create %s. Error: %s ", label, label, err)
+		return err
+	}
+
+	err = decodefirst(reply, obj)
+
+	if err != nil {
+		log.Debugf("%s Create: Unable to decode JSON payload: %s ", label, err)
+		return err
+	}
+
+	log.Debugf("%s Create: done", label)
+	return nil
+}
+
+// DELETE "<entity>/<id>"
+func DeleteObject(c *Connection, label, entity, id string) error {
+	if id == "" {
+		err := fmt.Errorf("%s Delete: Empty ID, nothing to do", label)
+		return err
+	}
+
+	_, err := DeleteEntity(c, entity, id)
+
+	if err != nil {
+		log.Debugf("%s Delete: Unable to delete %s with ID: [%s] . Error: %s ", label, label, id, err)
+		return err
+	}
+
+	log.Debugf("%s Delete: Deleted %s with ID: [%s]", label, label, id)
+	return nil
+}
+
+// GET the list at "endpoint" (e.g. "enterprises" or "domains/<ID>/zones") and decode it into "list" -- a pointer to a slice. An empty reply leaves "list" as is
+func ListObjects(c *Connection, label, endpoint string, list interface{}) error {
+	reply, err := GetEntity(c, endpoint)
+
+	if err != nil {
+		log.Debugf("%s List: Unable to obtain list: %s ", label, err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("%s List: Empty list", label)
+		return nil
+	}
+
+	err = json.Unmarshal(reply, list)
+
+	if err != nil {
+		log.Debugf("%s List: Unable to decode JSON payload: %s ", label, err)
+		return err
+	}
+
+	log.Debugf("%s List: done", label)
+	return nil
+}
+
+// The VSD replies with an array of JSON objects. Decode the first one into "obj", replacing its previous contents
+func decodefirst(reply []byte, obj interface{}) error {
+	var objs []json.RawMessage
+
+	if err := json.Unmarshal(reply, &objs); err != nil {
+		return err
+	}
+
+	if len(objs) == 0 {
+		return errors.New("Empty reply")
+	}
+
+	// XXX - Mutate the receiver
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return json.Unmarshal(objs[0], obj)
+}
+
 ////////
 //////// Auxiliary functions
 ////////
@@ -106,6 +240,21 @@ func (c *Connection) String() string {
 	str := fmt.Sprintf("\n    Endpoint URL: [%s]\n", c.Url)
 	str = str + fmt.Sprintf("    API version: [%s]\n", c.Apivers)
 
+	if c.Release != "" {
+		str = str + fmt.Sprintf("    VSD release: [%s]\n", c.Release)
+	}
+
+	if c.CurrentApivers != "" {
+		str = str + fmt.Sprintf("    Current VSD API version: [%s]\n", c.CurrentApivers)
+	}
+
+	if len(c.Versions) != 0 {
+		str = str + fmt.Sprint("    API versions supported by the VSD:\n")
+		for _, v := range c.Versions {
+			str = str + fmt.Sprintf("        [%s]: %s\n", v.Version, v.Status)
+		}
+	}
+
 	if c.token != nil {
 		str = str + fmt.Sprintf("    Connection established as User: [%s], Enterprise: [%s] \n", c.token.UserName, c.token.EnterpriseName)
 	} else {
@@ -115,12 +264,111 @@ func (c *Connection) String() string {
 	return str
 }
 
+// How long ProbeVersions waits for the VSD to answer
+var ProbeTimeout = 10 * time.Second
+
+// Query the VSD for the API versions it supports (unauthenticated "GET /nuage"). Records the list of versions and their status, as well as the "CURRENT" API version.
+func (c *Connection) ProbeVersions() error {
+	var vers APIVersions
+
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	client := &http.Client{Transport: tr, Timeout: ProbeTimeout}
+
+	log.Debugf("Probing supported API versions at: %s", c.Url+"/nuage")
+	resp, err := client.Get(c.Url + "/nuage")
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		log.Debugf("API versions probe to ["+c.Url+"/nuage"+"] failed with status: %s", resp.Status)
+		err = fmt.Errorf("HTTP status: %s", resp.Status)
+		return err
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&vers)
+
+	if err != nil {
+		log.Debugf("Unable to decode JSON payload: %s", err.Error())
+		return err
+	}
+
+	c.Versions = vers.Versions
+	c.CurrentApivers = ""
+
+	for _, v := range c.Versions {
+		if v.Status == "CURRENT" {
+			c.CurrentApivers = v.Version
+		}
+	}
+
+	log.Debugf("Current API version: [%s], supported API versions: %#v", c.CurrentApivers, c.Versions)
+	return nil
+}
+
+// Status of the configured API version (c.Apivers) as advertised by the VSD. Empty string if the VSD does not list it. The VSD uses dot notation (e.g. "v3.2") whereas we use underscores (e.g. "v3_2")
+func (c *Connection) ApiversStatus() string {
+	for _, v := range c.Versions {
+		if strings.Replace(v.Version, ".", "_", -1) == c.Apivers {
+			return v.Status
+		}
+	}
+	return ""
+}
+
+// A copy of the connection -- sharing its session -- using another API version (e.g. to reach entities not implemented for the API version in use)
+func (c *Connection) WithApivers(apivers string) *Connection {
+	cc := *c
+	cc.Apivers = apivers
+	return &cc
+}
+
+// Version negotiation: Set the API version (c.Apivers) to the first of the "implemented" API versions (in order of preference, e.g. "v4_0", "v3_2") advertised by the VSD. "CURRENT" versions are preferred over "DEPRECATED" ones.
+// Requires a prior ProbeVersions()
+func (c *Connection) NegotiateApivers(implemented ...string) error {
+	for _, status := range []string{"CURRENT", "DEPRECATED"} {
+		for _, apivers := range implemented {
+			for _, v := range c.Versions {
+				if v.Status == status && strings.Replace(v.Version, ".", "_", -1) == apivers {
+					log.Debugf("Negotiated API version: [%s] (%s)", apivers, status)
+					c.Apivers = apivers
+					return nil
+				}
+			}
+		}
+	}
+
+	err := fmt.Errorf("None of the API versions %v is supported by the VSD. Current API version: [%s]", implemented, c.CurrentApivers)
+	return err
+}
+
 // Initialize Nuage API connection using username & password. Stores a valid Authtoken upon success.
+// Prior to authenticating, checks that the configured API version (c.Apivers) is supported by the VSD. Fails if it is not, warns if it is deprecated.
 func (c *Connection) Connect(org, user, pass string) error {
 	// var err error
 
 	var auth []Authtoken
 
+	err := c.ProbeVersions()
+
+	if err != nil {
+		// Older VSD releases may not answer this. Not fatal -- the first API call will tell.
+		log.Warnf("Unable to determine the API versions supported by the VSD: %s", err)
+	} else {
+		switch c.ApiversStatus() {
+		case "":
+			err = fmt.Errorf("API version [%s] not supported by the VSD. Current API version: [%s]", c.Apivers, c.CurrentApivers)
+			return err
+		case "DEPRECATED":
+			log.Warnf("API version [%s] is deprecated. Current API version: [%s]", c.Apivers, c.CurrentApivers)
+		}
+	}
+
 	// log.Debugf("Base64 encoding of %s is: %s", user+":"+pass, base64.URLEncoding.EncodeToString([]byte(user+":"+pass)))
 
 	// Get APIkey + its timestamp from "/nuage/api/v1_0/me"
@@ -170,6 +418,42 @@ func (c *Connection) Connect(org, user, pass string) error {
 	// Keep a pointer to this connection so we can reuse the credentials
 	c.token = &auth[0]
 
+	if err := c.ProbeRelease(); err != nil {
+		// Not fatal -- e.g. users without access to the VSPs
+		log.Warnf("Unable to determine the VSD release: %s", err)
+	}
+
+	return nil
+}
+
+// Query the VSD for its release: The product version of the VSP (VSD cluster) it belongs to. Requires an established connection.
+func (c *Connection) ProbeRelease() error {
+	var vsps []struct {
+		ProductVersion string `json:"productVersion"`
+	}
+
+	c.Release = ""
+
+	reply, err := GetEntity(c, "vsps")
+	if err != nil {
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VSD release: Empty list of VSPs")
+		return nil
+	}
+
+	if err = json.Unmarshal(reply, &vsps); err != nil {
+		log.Debugf("VSD release: Unable to decode JSON payload: %s", err)
+		return err
+	}
+
+	if len(vsps) > 0 {
+		c.Release = vsps[0].ProductVersion
+	}
+
+	log.Debugf("VSD release: [%s]", c.Release)
 	return nil
 }
 
@@ -186,8 +470,8 @@ func nuagetransaction(c *Connection, method string, url string, jsonpayload []by
 	req.Header.Set("Authorization", "XREST "+base64.URLEncoding.EncodeToString([]byte(c.token.UserName+":"+c.token.Apikey)))
 	req.Header.Set("Content-Type", "application/json")
 
-	// "POST" methods require a valid payload.
-	if method == "POST" && len(jsonpayload) != 0 {
+	// "POST" and "PUT" methods require a valid payload.
+	if (method == "POST" || method == "PUT") && len(jsonpayload) != 0 {
 		// If we are passed a payload, encode that
 		req.Body = ioutil.NopCloser(bytes.NewBuffer(jsonpayload))
 		// log.Debugf("Request payload: %s", string(jsonpayload))
@@ -3,9 +3,24 @@ package nuage
 type Connection struct {
 	Url     string
 	Apivers string
+	// Populated by Connect() from the API versions advertised by the VSD. CurrentApivers: The one with status "CURRENT"
+	CurrentApivers string
+	Versions       []APIVersion
+	// Populated by Connect(): The VSD release (product version, e.g. "4.0.R4") of the VSP the VSD belongs to
+	Release string
 	token   *Authtoken
 }
 
+// API version as advertised by the VSD under "/nuage". Status is one of "CURRENT", "DEPRECATED" (or whatever else the VSD sees fit)
+type APIVersion struct {
+	Version string `json:"version"`
+	Status  string `json:"status"`
+}
+
+type APIVersions struct {
+	Versions []APIVersion `json:"versions"`
+}
+
 type Authtoken struct {
 	Apikey         string
 	APIKeyExpiry   int64
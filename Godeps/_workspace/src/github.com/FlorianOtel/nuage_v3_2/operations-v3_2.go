@@ -1,8 +1,15 @@
 package nuage_v3_2
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
 
 	// "reflect"
 
@@ -141,6 +148,128 @@ func (vms *VirtualMachineslice) List(c *nuage.Connection) error {
 	return nil
 }
 
+// How often "WaitResync" polls a VirtualMachine resync
+var VMResyncPollInterval = 2 * time.Second
+
+// Trigger a resync of the VirtualMachine, i.e. have the VSD re-learn its state from the VRS. Caller must initialize the VirtualMachine ID (vm.ID)
+// The resync is asynchronous -- use "WaitResync" to follow it, with the resync status LastRequestTimestamp as it was prior to triggering it
+func (vm *VirtualMachine) Resync(c *nuage.Connection) error {
+
+	if vm.ID == "" {
+		err := fmt.Errorf("VirtualMachine Resync: Empty VirtualMachine ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.CreateEntity(c, "vms/"+vm.ID+"/resync", []byte("{}"))
+
+	if err != nil {
+		log.Debugf("VirtualMachine Resync: Unable to resync VirtualMachine with ID: [%s] . Error: %s ", vm.ID, err)
+		return err
+	}
+
+	// The VSD may or may not send back the resync status
+	var ra [1]VMResync
+	if len(reply) > 0 && json.Unmarshal(reply, &ra) == nil {
+		vm.ResyncInfo = ra[0]
+	}
+
+	log.Debugf("VirtualMachine Resync: Triggered resync of VirtualMachine with ID: [%s]", vm.ID)
+	return nil
+}
+
+// Poll the VirtualMachine (by ID, vm.ID) until a resync requested after "since" (the resync status LastRequestTimestamp prior to triggering it) has been carried out, or until "timeout" expires (no timeout if 0).
+// If not nil, "progress" is called with the resync status after every poll. Returns an error if the resync failed or timed out
+func (vm *VirtualMachine) WaitResync(c *nuage.Connection, since int64, timeout time.Duration, progress func(VMResync)) error {
+
+	if vm.ID == "" {
+		err := fmt.Errorf("VirtualMachine Wait Resync: Empty VirtualMachine ID, nothing to do")
+		return err
+	}
+
+	start := time.Now()
+
+	for {
+		if err := vm.Get(c); err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(vm.ResyncInfo)
+		}
+
+		if vm.ResyncInfo.Done(since) {
+			break
+		}
+
+		if timeout > 0 && time.Since(start) >= timeout {
+			err := fmt.Errorf("VirtualMachine Wait Resync: Timed out after %s waiting for the resync of VirtualMachine with ID: [%s] . Status: [%s]", timeout, vm.ID, vm.ResyncInfo.Status)
+			return err
+		}
+
+		time.Sleep(VMResyncPollInterval)
+	}
+
+	if vm.ResyncInfo.Status != "SUCCESS" {
+		err := fmt.Errorf("VirtualMachine Wait Resync: Resync of VirtualMachine with ID: [%s] failed. Status: [%s]", vm.ID, vm.ResyncInfo.Status)
+		return err
+	}
+
+	log.Debugf("VirtualMachine Wait Resync: VirtualMachine with ID: [%s] resynced", vm.ID)
+	return nil
+}
+
+// Whether a resync requested after "since" (a LastRequestTimestamp) has been initiated and is no longer in progress. An earlier status, or one without a Status, does not count
+func (r VMResync) Done(since int64) bool {
+	if r.LastRequestTimestamp <= since || r.LastTimeResyncInitiated < r.LastRequestTimestamp {
+		return false
+	}
+	return r.Status != "" && r.Status != "IN_PROGRESS"
+}
+
+// Resync all the VirtualMachines on a given hypervisor (by IP address). Returns the VirtualMachines resynced, as they were prior to triggering it (see "WaitResync").
+// Keeps going if some of them fail, returning an error listing those
+func ResyncHypervisor(c *nuage.Connection, hypervisorip string) ([]VirtualMachine, error) {
+
+	if hypervisorip == "" {
+		err := fmt.Errorf("Hypervisor Resync: Empty hypervisor IP, nothing to do")
+		return nil, err
+	}
+
+	var vms VirtualMachineslice
+	if err := vms.List(c); err != nil {
+		return nil, err
+	}
+
+	var resynced []VirtualMachine
+	var failed []string
+
+	for _, vm := range vms {
+		if vm.HypervisorIP != hypervisorip {
+			continue
+		}
+		listed := vm
+		if err := vm.Resync(c); err != nil {
+			failed = append(failed, vm.Name+" ["+vm.ID+"]")
+			continue
+		}
+		resynced = append(resynced, listed)
+	}
+
+	log.Debugf("Hypervisor Resync: Resynced [%d] VirtualMachines on hypervisor: [%s], [%d] failed", len(resynced), hypervisorip, len(failed))
+
+	if len(failed) > 0 {
+		err := fmt.Errorf("Hypervisor Resync: Unable to resync VirtualMachines: %s", strings.Join(failed, ", "))
+		return resynced, err
+	}
+
+	if len(resynced) == 0 {
+		err := fmt.Errorf("Hypervisor Resync: No VirtualMachines on hypervisor: [%s]", hypervisorip)
+		return nil, err
+	}
+
+	return resynced, nil
+}
+
 ////////
 //////// VMInterface operations. OBS: Some are methods for other entities (e.g. Subnet / Domain / ... VMInterface List).
 ////////
@@ -211,6 +340,39 @@ func (s *Subnet) VMInterfacesList(c *nuage.Connection) ([]VMInterface, error) {
 
 }
 
+// VMInterfaces list for an L2 Domain.  Caller must initialize the L2 Domain ID (l2d.ID)
+func (l2d *L2Domain) VMInterfacesList(c *nuage.Connection) ([]VMInterface, error) {
+
+	if l2d.ID == "" {
+		err := fmt.Errorf("L2 Domain VMInterfaces List: Empty L2 Domain ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "l2domains/"+l2d.ID+"/vminterfaces")
+
+	if err != nil {
+		log.Debugf("L2 Domain VMInterfaces List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("L2 Domain VMInterfaces List: Empty list")
+		return nil, nil
+	}
+
+	var vmis []VMInterface
+
+	err = json.Unmarshal(reply, &vmis)
+	if err != nil {
+		log.Debugf("L2 Domain VMInterfaces List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("L2 Domain VMInterfaces List: done")
+	return vmis, nil
+
+}
+
 // VMInterface Delete.  Caller must initialize the VMInterface ID (vmi.ID)
 func (vmi *VMInterface) Delete(c *nuage.Connection) error {
 
@@ -309,6 +471,23 @@ func (s *Subnet) AddVPort(c *nuage.Connection, vp VPort) (VPort, error) {
 		return vpa[0], err
 	}
 
+	// Per-type checks: HOST and BRIDGE VPorts live on a gateway VLAN, VM VPorts don't
+	switch vp.Type {
+	case "VM":
+		if vp.VLANID != "" {
+			err := fmt.Errorf("Subnet Add VPort: VM VPort can't be bound to a VLAN (VLAN ID: [%s])", vp.VLANID)
+			return vpa[0], err
+		}
+	case "HOST", "BRIDGE":
+		if vp.VLANID == "" {
+			err := fmt.Errorf("Subnet Add VPort: %s VPort requires a gateway VLAN ID", vp.Type)
+			return vpa[0], err
+		}
+	default:
+		err := fmt.Errorf("Subnet Add VPort: Invalid VPort type: [%s]. Must be one of: VM, HOST, BRIDGE", vp.Type)
+		return vpa[0], err
+	}
+
 	jsonvport, _ := json.MarshalIndent(vp, "", "\t")
 	reply, err := nuage.CreateEntity(c, "subnets/"+s.ID+"/vports", jsonvport)
 
@@ -392,6 +571,38 @@ func (s *Subnet) VPortsList(c *nuage.Connection) ([]VPort, error) {
 
 }
 
+// VPorts list for an L2 Domain.  Caller must initialize the L2 Domain ID (l2d.ID)
+func (l2d *L2Domain) VPortsList(c *nuage.Connection) ([]VPort, error) {
+
+	if l2d.ID == "" {
+		err := fmt.Errorf("L2 Domain VPorts List: Empty L2 Domain ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "l2domains/"+l2d.ID+"/vports")
+
+	if err != nil {
+		log.Debugf("L2 Domain VPorts List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("L2 Domain VPorts List: Empty list")
+		return nil, nil
+	}
+
+	var vports []VPort
+
+	err = json.Unmarshal(reply, &vports)
+	if err != nil {
+		log.Debugf("L2 Domain VPorts List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+	log.Debug("L2 Domain VPorts List: done")
+	return vports, nil
+
+}
+
 // VPort Delete.  Caller must initialize the VPort ID (vp.ID)
 func (vp *VPort) Delete(c *nuage.Connection) error {
 
@@ -585,6 +796,147 @@ func (ss *Subnetslice) List(c *nuage.Connection, parentid string) error {
 	return nil
 }
 
+////////
+//////// Subnet template methods
+////////
+
+// Caller must populate the Subnet template ID (st.ID)
+func (st *Subnettemplate) Delete(c *nuage.Connection) error {
+	if st == nil {
+		err := fmt.Errorf("Subnet template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if st.ID == "" {
+		err := fmt.Errorf("Subnet template Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "subnettemplates", st.ID)
+
+	if err != nil {
+		log.Debugf("Subnet template Delete: Unable to delete Subnet template with ID: [%s] . Error: %s ", st.ID, err)
+		return err
+	}
+
+	log.Debugf("Subnet template Delete: Deleted Subnet template with ID: [%s] ", st.ID)
+	return nil
+}
+
+// Assumes the method receiver was allocated using "new(Subnettemplate)"
+// Caller must populate:
+// - Name (st.Name)
+// - Parent Zone template ID (st.ParentID)
+// - Address (st.Address)
+// - Netmask (st.Netmask)
+// Optionally: Gateway (st.Gateway). Assigned by the VSD if empty
+func (st *Subnettemplate) Create(c *nuage.Connection) error {
+	if st == nil {
+		err := fmt.Errorf("Subnet template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if st.Name == "" {
+		err := fmt.Errorf("Subnet template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if st.ParentID == "" {
+		err := fmt.Errorf("Subnet template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if st.Address == "" {
+		err := fmt.Errorf("Subnet template Create: Empty Address, nothing to do")
+		return err
+	}
+
+	if st.Netmask == "" {
+		err := fmt.Errorf("Subnet template Create: Empty Netmask, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var sta [1]Subnettemplate
+	// XXX - This copies the supplied fields
+	sta[0] = *st
+
+	jsonst, _ := json.MarshalIndent(sta[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "zonetemplates/"+st.ParentID+"/subnettemplates", jsonst)
+
+	if err != nil {
+		log.Debugf("Subnet template Create: Unable to create Subnet template with name: [%s] . Error: %s ", st.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &sta)
+
+	if err != nil {
+		log.Debugf("Subnet template Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*st = sta[0]
+	log.Debugf("Subnet template Create: Created Subnet template with ID: [%s]", st.ID)
+	return nil
+}
+
+// Get by Subnet template ID (st.ID)
+func (st *Subnettemplate) Get(c *nuage.Connection) error {
+	if st.ID == "" {
+		err := fmt.Errorf("Subnet template Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "subnettemplates/"+st.ID)
+
+	if err != nil {
+		log.Debugf("Subnet template Get: Unable to get Subnet template with ID: [%s] . Error: %s ", st.ID, err)
+		return err
+	}
+
+	var sta [1]Subnettemplate
+	err = json.Unmarshal(reply, &sta)
+	if err != nil {
+		log.Debugf("Subnet template Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*st = sta[0]
+	log.Debugf("Subnet template Get: Found Subnet template with Name: [%s] and ID: [%s]", st.Name, st.ID)
+	return nil
+}
+
+// Subnet template list for a given Zone template ID
+func (sts *Subnettemplateslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Subnet template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "zonetemplates/"+parentid+"/subnettemplates")
+
+	if err != nil {
+		log.Debugf("Subnet template List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Subnet template List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, sts)
+
+	if err != nil {
+		log.Debugf("Subnet template List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Subnet template List: done")
+	return nil
+}
+
 ////////
 //////// Zone methods
 ////////
@@ -1110,6 +1462,7479 @@ func (dts *Domaintemplateslice) List(c *nuage.Connection, parentid string) error
 	return nil
 }
 
+////////
+//////// L2 Domain methods
+////////
+
+// Caller must populate L2 domain ID (l2d.ID)
+func (l2d *L2Domain) Delete(c *nuage.Connection) error {
+	if l2d == nil {
+		err := fmt.Errorf("L2 Domain Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if l2d.ID == "" {
+		err := fmt.Errorf("L2 Domain Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "l2domains", l2d.ID)
+
+	if err != nil {
+		log.Debugf("L2 Domain Delete: Unable to delete L2 Domain with ID: [%s] . Error: %s ", l2d.ID, err)
+		return err
+	}
+
+	log.Debugf("L2 Domain Delete: Deleted L2 domain with ID: [%s] ", l2d.ID)
+	return nil
+}
+
+// Assumes the method receiver was allocated using "new(L2Domain)"
+// Caller must populate:
+// - Name (l2d.Name)
+// - Parent Enterprise ID (l2d.ParentID)
+// - L2 Domain Template ID (l2d.TemplateID)
+func (l2d *L2Domain) Create(c *nuage.Connection) error {
+	if l2d == nil {
+		err := fmt.Errorf("L2 Domain Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if l2d.Name == "" {
+		err := fmt.Errorf("L2 Domain Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if l2d.ParentID == "" {
+		err := fmt.Errorf("L2 Domain Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if l2d.TemplateID == "" {
+		err := fmt.Errorf("L2 Domain Create: Empty L2 domain template ID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var l2da [1]L2Domain
+	// XXX - This copies the supplied Name, ParentID and TemplateID
+	l2da[0] = *l2d
+
+	jsonl2domain, _ := json.MarshalIndent(l2da[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+l2d.ParentID+"/l2domains", jsonl2domain)
+
+	if err != nil {
+		log.Debugf("L2 Domain Create: Unable to create L2 Domain with name: [%s] . Error: %s ", l2d.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &l2da)
+
+	if err != nil {
+		log.Debugf("L2 Domain Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*l2d = l2da[0]
+	log.Debugf("L2 Domain Create: Created L2 Domain with ID: [%s]", l2d.ID)
+	return nil
+}
+
+// Get by L2 Domain ID (l2d.ID)
+func (l2d *L2Domain) Get(c *nuage.Connection) error {
+	if l2d.ID == "" {
+		err := fmt.Errorf("L2 Domain Get: Empty ID, nothing to do")
+		return err
+	}
+	reply, err := nuage.GetEntity(c, "l2domains/"+l2d.ID)
+
+	if err != nil {
+		log.Debugf("L2 Domain Get: Unable to get L2 domain with ID: [%s] . Error: %s ", l2d.ID, err)
+		return err
+	}
+
+	var l2da [1]L2Domain
+	err = json.Unmarshal(reply, &l2da)
+	if err != nil {
+		log.Debugf("L2 Domain Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*l2d = l2da[0]
+	log.Debugf("L2 Domain Get: Found L2 Domain with Name: [%s] and ID: [%s]", l2d.Name, l2d.ID)
+	return nil
+}
+
+func (l2ds *L2Domainslice) List(c *nuage.Connection, parentid string) error {
+	var reply []byte
+	var err error
+
+	if parentid == "" { // get global list of L2 domains
+		reply, err = nuage.GetEntity(c, "l2domains")
+	} else {
+		// get the list of L2 domains for a given enterprise ID
+		reply, err = nuage.GetEntity(c, "enterprises/"+parentid+"/l2domains")
+	}
+
+	if err != nil {
+		log.Debugf("L2 Domain List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("L2 Domain List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, l2ds)
+
+	if err != nil {
+		log.Debugf("L2 Domain List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("L2 Domain List: done")
+	return nil
+}
+
+////////
+//////// L2 Domaintemplate methods
+////////
+
+// Assumes the method receiver was allocated using "new(L2Domaintemplate)"
+// Caller must populate the ID (l2dt.ID)
+func (l2dt *L2Domaintemplate) Delete(c *nuage.Connection) error {
+	if l2dt == nil {
+		err := fmt.Errorf("L2 Domain template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if l2dt.ID == "" {
+		err := fmt.Errorf("L2 Domain template Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "l2domaintemplates", l2dt.ID)
+
+	if err != nil {
+		log.Debugf("L2 Domain template Delete: Unable to delete L2 Domain template with ID: [%s] . Error: %s ", l2dt.ID, err)
+		return err
+	}
+
+	log.Debugf("L2 Domain template Delete: Deleted L2 domain template with ID: [%s] ", l2dt.ID)
+	return nil
+}
+
+// Assumes the method receiver was allocated using "new(L2Domaintemplate)"
+// Caller must populate Name (l2dt.Name) and ParentID (l2dt.ParentID)
+// For DHCP managed L2 domain templates (l2dt.DHCPManaged) caller must also populate the Address (l2dt.Address) and Netmask (l2dt.Netmask). Gateway (l2dt.Gateway) is optional.
+func (l2dt *L2Domaintemplate) Create(c *nuage.Connection) error {
+	if l2dt == nil {
+		err := fmt.Errorf("L2 Domain template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if l2dt.Name == "" {
+		err := fmt.Errorf("L2 Domain template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if l2dt.ParentID == "" {
+		err := fmt.Errorf("L2 Domain template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if l2dt.DHCPManaged && (l2dt.Address == "" || l2dt.Netmask == "") {
+		err := fmt.Errorf("L2 Domain template Create: DHCP managed L2 Domain template needs an Address & Netmask. Nothing to do")
+		return err
+	}
+
+	if !l2dt.DHCPManaged && (l2dt.Address != "" || l2dt.Netmask != "" || l2dt.Gateway != "") {
+		err := fmt.Errorf("L2 Domain template Create: Unmanaged L2 Domain template cannot have an Address, Netmask or Gateway. Nothing to do")
+		return err
+	}
+
+	if l2dt.DHCPManaged && l2dt.IPType == "" {
+		l2dt.IPType = "IPV4"
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var l2dta [1]L2Domaintemplate
+
+	// XXX - This copies the supplied Name, ParentID and addressing
+	l2dta[0] = *l2dt
+
+	jsonl2dt, _ := json.MarshalIndent(l2dta[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+l2dt.ParentID+"/l2domaintemplates", jsonl2dt)
+
+	if err != nil {
+		log.Debugf("L2 Domain template Create: Unable to create L2 Domain template with name: [%s] . Error: %s ", l2dt.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &l2dta)
+
+	if err != nil {
+		log.Debugf("L2 Domain template Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*l2dt = l2dta[0]
+	log.Debugf("L2 Domain template Create: Created L2 Domain template with ID: [%s]", l2dt.ID)
+	return nil
+}
+
+// GET by ID (l2dt.ID)
+func (l2dt *L2Domaintemplate) Get(c *nuage.Connection) error {
+	if l2dt.ID == "" {
+		err := fmt.Errorf("L2 Domain template Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "l2domaintemplates/"+l2dt.ID)
+
+	if err != nil {
+		log.Debugf("L2 Domain template Get: Unable to get L2 domain template with ID: [%s] . Error: %s ", l2dt.ID, err)
+		return err
+	}
+
+	var l2dta [1]L2Domaintemplate
+	err = json.Unmarshal(reply, &l2dta)
+	if err != nil {
+		log.Debugf("L2 Domain template Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*l2dt = l2dta[0]
+	log.Debugf("L2 Domain template Get: Found L2 Domain template with Name: [%s] and ID: [%s]", l2dt.Name, l2dt.ID)
+	return nil
+}
+
+func (l2dts *L2Domaintemplateslice) List(c *nuage.Connection, parentid string) error {
+	var reply []byte
+	var err error
+
+	if parentid == "" { // get global list of L2 domain templates
+		reply, err = nuage.GetEntity(c, "l2domaintemplates")
+	} else {
+		// get the list of L2 domain templates for a given enterprise ID
+		reply, err = nuage.GetEntity(c, "enterprises/"+parentid+"/l2domaintemplates")
+	}
+
+	if err != nil {
+		log.Debugf("L2 Domain templates List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("L2 Domain templates List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, l2dts)
+
+	if err != nil {
+		log.Debugf("L2 Domain template List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("L2 Domain template List: done")
+	return nil
+}
+
+////////
+//////// Multi-NIC VPort methods
+////////
+
+// Delete by Multi-NIC VPort ID (mnv.ID)
+func (mnv *MultiNICVPort) Delete(c *nuage.Connection) error {
+	if mnv.ID == "" {
+		err := fmt.Errorf("Multi-NIC VPort Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "multinicvports", mnv.ID)
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort Delete: Unable to delete Multi-NIC VPort with ID: [%s] . Error: %s ", mnv.ID, err)
+		return err
+	}
+
+	log.Debugf("Multi-NIC VPort Delete: Deleted Multi-NIC VPort with ID: [%s] ", mnv.ID)
+	return nil
+}
+
+// Create a new Multi-NIC VPort. Assumes the method receiver was allocated using "new(MultiNICVPort)"
+// Caller must populate:
+// - Name (mnv.Name)
+// - Parent Enterprise ID (mnv.ParentID)
+// Member VPorts are attached afterwards -- see "AttachVPorts"
+func (mnv *MultiNICVPort) Create(c *nuage.Connection) error {
+	if mnv == nil {
+		err := fmt.Errorf("Multi-NIC VPort Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if mnv.Name == "" {
+		err := fmt.Errorf("Multi-NIC VPort Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if mnv.ParentID == "" {
+		err := fmt.Errorf("Multi-NIC VPort Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var mnva [1]MultiNICVPort
+	// XXX - This copies the supplied fields
+	mnva[0] = *mnv
+
+	jsonmnv, _ := json.MarshalIndent(mnva[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+mnv.ParentID+"/multinicvports", jsonmnv)
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort Create: Unable to create Multi-NIC VPort with name: [%s] . Error: %s ", mnv.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &mnva)
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*mnv = mnva[0]
+	log.Debugf("Multi-NIC VPort Create: Created Multi-NIC VPort with ID: [%s]", mnv.ID)
+	return nil
+}
+
+// Get by Multi-NIC VPort ID (mnv.ID)
+func (mnv *MultiNICVPort) Get(c *nuage.Connection) error {
+	if mnv.ID == "" {
+		err := fmt.Errorf("Multi-NIC VPort Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "multinicvports/"+mnv.ID)
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort Get: Unable to get Multi-NIC VPort with ID: [%s] . Error: %s ", mnv.ID, err)
+		return err
+	}
+
+	var mnva [1]MultiNICVPort
+	err = json.Unmarshal(reply, &mnva)
+	if err != nil {
+		log.Debugf("Multi-NIC VPort Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*mnv = mnva[0]
+	log.Debugf("Multi-NIC VPort Get: Found Multi-NIC VPort with name: [%s] and ID: [%s]", mnv.Name, mnv.ID)
+	return nil
+}
+
+// Multi-NIC VPort list for a given Enterprise ID
+func (mnvs *MultiNICVPortslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Multi-NIC VPort List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/multinicvports")
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Multi-NIC VPort List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, mnvs)
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Multi-NIC VPort List: done")
+	return nil
+}
+
+// VPorts list for a Multi-NIC VPort.  Caller must initialize the Multi-NIC VPort ID (mnv.ID)
+func (mnv *MultiNICVPort) VPortsList(c *nuage.Connection) ([]VPort, error) {
+
+	if mnv.ID == "" {
+		err := fmt.Errorf("Multi-NIC VPort VPorts List: Empty Multi-NIC VPort ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "multinicvports/"+mnv.ID+"/vports")
+
+	if err != nil {
+		log.Debugf("Multi-NIC VPort VPorts List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Multi-NIC VPort VPorts List: Empty list")
+		return nil, nil
+	}
+
+	var vports []VPort
+
+	err = json.Unmarshal(reply, &vports)
+	if err != nil {
+		log.Debugf("Multi-NIC VPort VPorts List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Multi-NIC VPort VPorts List: done")
+	return vports, nil
+
+}
+
+// Attach VPorts (by ID) to a Multi-NIC VPort. A VPort belongs to at most one Multi-NIC VPort. Caller must initialize the Multi-NIC VPort ID (mnv.ID)
+func (mnv *MultiNICVPort) AttachVPorts(c *nuage.Connection, vportids ...string) error {
+	if mnv.ID == "" {
+		err := fmt.Errorf("Multi-NIC VPort Attach VPorts: Empty Multi-NIC VPort ID, nothing to do")
+		return err
+	}
+
+	for _, id := range vportids {
+		vp := new(VPort)
+		vp.ID = id
+		if err := vp.Get(c); err != nil {
+			return err
+		}
+
+		if vp.MultiNICVPortID == mnv.ID {
+			log.Debugf("Multi-NIC VPort Attach VPorts: VPort with ID: [%s] already attached", id)
+			continue
+		}
+
+		if vp.MultiNICVPortID != "" {
+			err := fmt.Errorf("Multi-NIC VPort Attach VPorts: VPort with ID: [%s] already attached to Multi-NIC VPort with ID: [%s]", id, vp.MultiNICVPortID)
+			return err
+		}
+
+		jsonmnv, _ := json.Marshal(map[string]string{"multiNICVPortID": mnv.ID})
+		if _, err := nuage.UpdateEntity(c, "vports/"+id, jsonmnv); err != nil {
+			log.Debugf("Multi-NIC VPort Attach VPorts: Unable to attach VPort with ID: [%s] to Multi-NIC VPort with ID: [%s] . Error: %s ", id, mnv.ID, err)
+			return err
+		}
+
+		log.Debugf("Multi-NIC VPort Attach VPorts: Attached VPort with ID: [%s] to Multi-NIC VPort with ID: [%s]", id, mnv.ID)
+	}
+
+	return nil
+}
+
+// Detach VPorts (by ID) from a Multi-NIC VPort. Caller must initialize the Multi-NIC VPort ID (mnv.ID)
+func (mnv *MultiNICVPort) DetachVPorts(c *nuage.Connection, vportids ...string) error {
+	if mnv.ID == "" {
+		err := fmt.Errorf("Multi-NIC VPort Detach VPorts: Empty Multi-NIC VPort ID, nothing to do")
+		return err
+	}
+
+	for _, id := range vportids {
+		vp := new(VPort)
+		vp.ID = id
+		if err := vp.Get(c); err != nil {
+			return err
+		}
+
+		if vp.MultiNICVPortID != mnv.ID {
+			err := fmt.Errorf("Multi-NIC VPort Detach VPorts: VPort with ID: [%s] is not attached to Multi-NIC VPort with ID: [%s]", id, mnv.ID)
+			return err
+		}
+
+		// "null" clears the attachment. Can't use the VPort itself since "multiNICVPortID" is omitted when empty
+		jsonmnv := []byte(`{"multiNICVPortID": null}`)
+		if _, err := nuage.UpdateEntity(c, "vports/"+id, jsonmnv); err != nil {
+			log.Debugf("Multi-NIC VPort Detach VPorts: Unable to detach VPort with ID: [%s] from Multi-NIC VPort with ID: [%s] . Error: %s ", id, mnv.ID, err)
+			return err
+		}
+
+		log.Debugf("Multi-NIC VPort Detach VPorts: Detached VPort with ID: [%s] from Multi-NIC VPort with ID: [%s]", id, mnv.ID)
+	}
+
+	return nil
+}
+
+////////
+//////// Application methods
+////////
+
+// Delete by Application ID (app.ID)
+func (app *Application) Delete(c *nuage.Connection) error {
+	if app.ID == "" {
+		err := fmt.Errorf("Application Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "apps", app.ID)
+
+	if err != nil {
+		log.Debugf("Application Delete: Unable to delete Application with ID: [%s] . Error: %s ", app.ID, err)
+		return err
+	}
+
+	log.Debugf("Application Delete: Deleted Application with ID: [%s] ", app.ID)
+	return nil
+}
+
+// Create a new Application. Assumes the method receiver was allocated using "new(Application)"
+// Caller must populate:
+// - Name (app.Name)
+// - Parent Domain ID (app.ParentID)
+func (app *Application) Create(c *nuage.Connection) error {
+	if app == nil {
+		err := fmt.Errorf("Application Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if app.Name == "" {
+		err := fmt.Errorf("Application Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if app.ParentID == "" {
+		err := fmt.Errorf("Application Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	app.AssociatedDomainID = app.ParentID
+	app.AssociatedDomainType = "DOMAIN"
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var appa [1]Application
+	// XXX - This copies the supplied fields
+	appa[0] = *app
+
+	jsonapp, _ := json.MarshalIndent(appa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "domains/"+app.ParentID+"/apps", jsonapp)
+
+	if err != nil {
+		log.Debugf("Application Create: Unable to create Application with name: [%s] . Error: %s ", app.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &appa)
+
+	if err != nil {
+		log.Debugf("Application Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*app = appa[0]
+	log.Debugf("Application Create: Created Application with ID: [%s]", app.ID)
+	return nil
+}
+
+// Get by Application ID (app.ID)
+func (app *Application) Get(c *nuage.Connection) error {
+	if app.ID == "" {
+		err := fmt.Errorf("Application Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "apps/"+app.ID)
+
+	if err != nil {
+		log.Debugf("Application Get: Unable to get Application with ID: [%s] . Error: %s ", app.ID, err)
+		return err
+	}
+
+	var appa [1]Application
+	err = json.Unmarshal(reply, &appa)
+	if err != nil {
+		log.Debugf("Application Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*app = appa[0]
+	log.Debugf("Application Get: Found Application with name: [%s] and ID: [%s]", app.Name, app.ID)
+	return nil
+}
+
+// Application list for a given Domain ID (or global list if empty)
+func (apps *Applicationslice) List(c *nuage.Connection, parentid string) error {
+	var reply []byte
+	var err error
+
+	if parentid == "" { // get global list
+		reply, err = nuage.GetEntity(c, "apps")
+	} else {
+		// get the list for a given Domain ID
+		reply, err = nuage.GetEntity(c, "domains/"+parentid+"/apps")
+	}
+
+	if err != nil {
+		log.Debugf("Application List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Application List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, apps)
+
+	if err != nil {
+		log.Debugf("Application List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Application List: done")
+	return nil
+}
+
+// Tiers list for an Application.  Caller must initialize the Application ID (app.ID)
+func (app *Application) TiersList(c *nuage.Connection) ([]Tier, error) {
+
+	if app.ID == "" {
+		err := fmt.Errorf("Application Tiers List: Empty Application ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "apps/"+app.ID+"/tiers")
+
+	if err != nil {
+		log.Debugf("Application Tiers List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Application Tiers List: Empty list")
+		return nil, nil
+	}
+
+	var tiers []Tier
+
+	err = json.Unmarshal(reply, &tiers)
+	if err != nil {
+		log.Debugf("Application Tiers List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Application Tiers List: done")
+	return tiers, nil
+
+}
+
+// Flows list for an Application.  Caller must initialize the Application ID (app.ID)
+func (app *Application) FlowsList(c *nuage.Connection) ([]Flow, error) {
+
+	if app.ID == "" {
+		err := fmt.Errorf("Application Flows List: Empty Application ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "apps/"+app.ID+"/flows")
+
+	if err != nil {
+		log.Debugf("Application Flows List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Application Flows List: Empty list")
+		return nil, nil
+	}
+
+	var flows []Flow
+
+	err = json.Unmarshal(reply, &flows)
+	if err != nil {
+		log.Debugf("Application Flows List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Application Flows List: done")
+	return flows, nil
+
+}
+
+////////
+//////// Tier methods
+////////
+
+// Delete by Tier ID (t.ID)
+func (t *Tier) Delete(c *nuage.Connection) error {
+	if t.ID == "" {
+		err := fmt.Errorf("Tier Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "tiers", t.ID)
+
+	if err != nil {
+		log.Debugf("Tier Delete: Unable to delete Tier with ID: [%s] . Error: %s ", t.ID, err)
+		return err
+	}
+
+	log.Debugf("Tier Delete: Deleted Tier with ID: [%s] ", t.ID)
+	return nil
+}
+
+// Create a new Tier. Assumes the method receiver was allocated using "new(Tier)"
+// Caller must populate:
+// - Name (t.Name)
+// - Parent Application ID (t.ParentID)
+// - Optionally: Type (t.Type): "STANDARD" (default) or "NETWORK_MACRO", with the Network macro ID (t.AssociatedNetworkMacroID)
+// - Optionally, for "STANDARD" Tiers: Address, Netmask and Gateway (t.Address, t.Netmask, t.Gateway)
+func (t *Tier) Create(c *nuage.Connection) error {
+	if t == nil {
+		err := fmt.Errorf("Tier Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if t.Name == "" {
+		err := fmt.Errorf("Tier Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if t.ParentID == "" {
+		err := fmt.Errorf("Tier Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if t.Type == "" {
+		t.Type = "STANDARD"
+	}
+
+	if err := validtier(t); err != nil {
+		return fmt.Errorf("Tier Create: %s", err)
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var ta [1]Tier
+	// XXX - This copies the supplied fields
+	ta[0] = *t
+
+	jsont, _ := json.MarshalIndent(ta[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "apps/"+t.ParentID+"/tiers", jsont)
+
+	if err != nil {
+		log.Debugf("Tier Create: Unable to create Tier with name: [%s] . Error: %s ", t.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &ta)
+
+	if err != nil {
+		log.Debugf("Tier Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*t = ta[0]
+	log.Debugf("Tier Create: Created Tier with ID: [%s]", t.ID)
+	return nil
+}
+
+// Get by Tier ID (t.ID)
+func (t *Tier) Get(c *nuage.Connection) error {
+	if t.ID == "" {
+		err := fmt.Errorf("Tier Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "tiers/"+t.ID)
+
+	if err != nil {
+		log.Debugf("Tier Get: Unable to get Tier with ID: [%s] . Error: %s ", t.ID, err)
+		return err
+	}
+
+	var ta [1]Tier
+	err = json.Unmarshal(reply, &ta)
+	if err != nil {
+		log.Debugf("Tier Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*t = ta[0]
+	log.Debugf("Tier Get: Found Tier with name: [%s] and ID: [%s]", t.Name, t.ID)
+	return nil
+}
+
+// Bind a "STANDARD" Tier to a Zone or Subnet: parent type "zone" or "subnet" and ID. Caller must initialize the Tier ID (t.ID)
+func (t *Tier) Bind(c *nuage.Connection, parenttype, parentid string) error {
+	if err := t.Get(c); err != nil {
+		return err
+	}
+
+	if t.Type != "STANDARD" {
+		err := fmt.Errorf("Tier Bind: Tier with ID: [%s] is of type: [%s]. Only \"STANDARD\" Tiers can be bound to Zones or Subnets", t.ID, t.Type)
+		return err
+	}
+
+	if parenttype != "zone" && parenttype != "subnet" {
+		err := fmt.Errorf("Tier Bind: Invalid parent type: [%s]. Must be either \"zone\" or \"subnet\"", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Tier Bind: Empty %s ID, nothing to do", parenttype)
+		return err
+	}
+
+	jsonbind, _ := json.Marshal(map[string]string{
+		"associatedApplicationID":         t.ParentID,
+		"associatedApplicationObjectID":   t.ID,
+		"associatedApplicationObjectType": "TIER",
+	})
+	_, err := nuage.UpdateEntity(c, parenttype+"s/"+parentid, jsonbind)
+
+	if err != nil {
+		log.Debugf("Tier Bind: Unable to bind Tier with ID: [%s] to %s with ID: [%s] . Error: %s ", t.ID, parenttype, parentid, err)
+		return err
+	}
+
+	log.Debugf("Tier Bind: Bound Tier with ID: [%s] to %s with ID: [%s]", t.ID, parenttype, parentid)
+	return nil
+}
+
+// Unbind whatever Tier is bound to a Zone or Subnet: parent type "zone" or "subnet" and ID
+func UnbindTier(c *nuage.Connection, parenttype, parentid string) error {
+	if parenttype != "zone" && parenttype != "subnet" {
+		err := fmt.Errorf("Tier Unbind: Invalid parent type: [%s]. Must be either \"zone\" or \"subnet\"", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Tier Unbind: Empty %s ID, nothing to do", parenttype)
+		return err
+	}
+
+	// "null" clears the binding. Can't use the Zone / Subnet itself since those attributes are omitted when empty
+	jsonbind := []byte(`{"associatedApplicationID": null, "associatedApplicationObjectID": null, "associatedApplicationObjectType": null}`)
+	_, err := nuage.UpdateEntity(c, parenttype+"s/"+parentid, jsonbind)
+
+	if err != nil {
+		log.Debugf("Tier Unbind: Unable to unbind Tier from %s with ID: [%s] . Error: %s ", parenttype, parentid, err)
+		return err
+	}
+
+	log.Debugf("Tier Unbind: Unbound Tier from %s with ID: [%s]", parenttype, parentid)
+	return nil
+}
+
+////////
+//////// Flow methods
+////////
+
+// Delete by Flow ID (f.ID)
+func (f *Flow) Delete(c *nuage.Connection) error {
+	if f.ID == "" {
+		err := fmt.Errorf("Flow Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "flows", f.ID)
+
+	if err != nil {
+		log.Debugf("Flow Delete: Unable to delete Flow with ID: [%s] . Error: %s ", f.ID, err)
+		return err
+	}
+
+	log.Debugf("Flow Delete: Deleted Flow with ID: [%s] ", f.ID)
+	return nil
+}
+
+// Create a new Flow. Assumes the method receiver was allocated using "new(Flow)"
+// Caller must populate:
+// - Name (f.Name)
+// - Parent Application ID (f.ParentID)
+// - Origin and destination Tier IDs (f.OriginTierID, f.DestinationTierID), both Tiers of the same Application
+// - Optionally: Application service ID (f.AssociatedApplicationServiceID). All traffic otherwise
+func (f *Flow) Create(c *nuage.Connection) error {
+	if f == nil {
+		err := fmt.Errorf("Flow Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if f.Name == "" {
+		err := fmt.Errorf("Flow Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if f.ParentID == "" {
+		err := fmt.Errorf("Flow Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if f.OriginTierID == "" || f.DestinationTierID == "" {
+		err := fmt.Errorf("Flow Create: Empty origin or destination Tier ID, nothing to do")
+		return err
+	}
+
+	if f.OriginTierID == f.DestinationTierID {
+		err := fmt.Errorf("Flow Create: Origin and destination are the same Tier: [%s]", f.OriginTierID)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var fa [1]Flow
+	// XXX - This copies the supplied fields
+	fa[0] = *f
+
+	jsonf, _ := json.MarshalIndent(fa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "apps/"+f.ParentID+"/flows", jsonf)
+
+	if err != nil {
+		log.Debugf("Flow Create: Unable to create Flow with name: [%s] . Error: %s ", f.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &fa)
+
+	if err != nil {
+		log.Debugf("Flow Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*f = fa[0]
+	log.Debugf("Flow Create: Created Flow with ID: [%s]", f.ID)
+	return nil
+}
+
+// Get by Flow ID (f.ID)
+func (f *Flow) Get(c *nuage.Connection) error {
+	if f.ID == "" {
+		err := fmt.Errorf("Flow Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "flows/"+f.ID)
+
+	if err != nil {
+		log.Debugf("Flow Get: Unable to get Flow with ID: [%s] . Error: %s ", f.ID, err)
+		return err
+	}
+
+	var fa [1]Flow
+	err = json.Unmarshal(reply, &fa)
+	if err != nil {
+		log.Debugf("Flow Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*f = fa[0]
+	log.Debugf("Flow Get: Found Flow with name: [%s] and ID: [%s]", f.Name, f.ID)
+	return nil
+}
+
+////////
+//////// Application service methods
+////////
+
+// Delete by Application service ID (as.ID)
+func (as *ApplicationService) Delete(c *nuage.Connection) error {
+	if as.ID == "" {
+		err := fmt.Errorf("Application service Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "applicationservices", as.ID)
+
+	if err != nil {
+		log.Debugf("Application service Delete: Unable to delete Application service with ID: [%s] . Error: %s ", as.ID, err)
+		return err
+	}
+
+	log.Debugf("Application service Delete: Deleted Application service with ID: [%s] ", as.ID)
+	return nil
+}
+
+// Create a new Application service. Assumes the method receiver was allocated using "new(ApplicationService)"
+// Caller must populate:
+// - Name (as.Name)
+// - Parent type (as.ParentType): "enterprise" or "domain"
+// - Parent ID (as.ParentID)
+// - Protocol (as.Protocol): "ANY" or an IANA protocol number, e.g. "6" for TCP
+// - Optionally, for TCP / UDP: Ports (as.SourcePort, as.DestinationPort): "*", a port number or a port range (e.g. "1024-2048")
+func (as *ApplicationService) Create(c *nuage.Connection) error {
+	if as == nil {
+		err := fmt.Errorf("Application service Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if as.Name == "" {
+		err := fmt.Errorf("Application service Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if as.ParentType != "enterprise" && as.ParentType != "domain" {
+		err := fmt.Errorf("Application service Create: Invalid parent type: [%s]. Must be either \"enterprise\" or \"domain\"", as.ParentType)
+		return err
+	}
+
+	if as.ParentID == "" {
+		err := fmt.Errorf("Application service Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if err := validappservice(as); err != nil {
+		return fmt.Errorf("Application service Create: %s", err)
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var asa [1]ApplicationService
+	// XXX - This copies the supplied fields
+	asa[0] = *as
+
+	jsonas, _ := json.MarshalIndent(asa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, as.ParentType+"s/"+as.ParentID+"/applicationservices", jsonas)
+
+	if err != nil {
+		log.Debugf("Application service Create: Unable to create Application service with name: [%s] . Error: %s ", as.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &asa)
+
+	if err != nil {
+		log.Debugf("Application service Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*as = asa[0]
+	log.Debugf("Application service Create: Created Application service with ID: [%s]", as.ID)
+	return nil
+}
+
+// Get by Application service ID (as.ID)
+func (as *ApplicationService) Get(c *nuage.Connection) error {
+	if as.ID == "" {
+		err := fmt.Errorf("Application service Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "applicationservices/"+as.ID)
+
+	if err != nil {
+		log.Debugf("Application service Get: Unable to get Application service with ID: [%s] . Error: %s ", as.ID, err)
+		return err
+	}
+
+	var asa [1]ApplicationService
+	err = json.Unmarshal(reply, &asa)
+	if err != nil {
+		log.Debugf("Application service Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*as = asa[0]
+	log.Debugf("Application service Get: Found Application service with name: [%s] and ID: [%s]", as.Name, as.ID)
+	return nil
+}
+
+// Application service list for a given parent: "enterprise" or "domain" and parent ID
+func (ass *ApplicationServiceslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parenttype != "enterprise" && parenttype != "domain" {
+		err := fmt.Errorf("Application service List: Invalid parent type: [%s]. Must be either \"enterprise\" or \"domain\"", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Application service List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/applicationservices")
+
+	if err != nil {
+		log.Debugf("Application service List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Application service List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, ass)
+
+	if err != nil {
+		log.Debugf("Application service List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Application service List: done")
+	return nil
+}
+
+////////
+//////// Application designer -- auxiliary functions. Unexported
+////////
+
+func validtier(t *Tier) error {
+	switch t.Type {
+	case "STANDARD":
+		if t.Address == "" && t.Netmask == "" {
+			return nil
+		}
+		if net.ParseIP(t.Address).To4() == nil || net.ParseIP(t.Netmask).To4() == nil {
+			return fmt.Errorf("Invalid Tier address / netmask: [%s/%s]", t.Address, t.Netmask)
+		}
+		if t.Gateway != "" && net.ParseIP(t.Gateway).To4() == nil {
+			return fmt.Errorf("Invalid Tier gateway: [%s]", t.Gateway)
+		}
+	case "NETWORK_MACRO":
+		if t.AssociatedNetworkMacroID == "" {
+			return fmt.Errorf("Tier type: [NETWORK_MACRO] requires a Network macro ID")
+		}
+	default:
+		return fmt.Errorf("Invalid Tier type: [%s]. Must be one of: STANDARD, NETWORK_MACRO", t.Type)
+	}
+	return nil
+}
+
+// Same protocol and port rules as ACL entries (see "validprotoports"). An empty protocol defaults to "ANY"
+func validappservice(as *ApplicationService) error {
+	if as.Protocol == "" {
+		as.Protocol = "ANY"
+	}
+
+	return validprotoports(as.Protocol, as.SourcePort, as.DestinationPort)
+}
+
+////////
+//////// Alarm methods
+////////
+
+// Get by Alarm ID (a.ID)
+func (a *Alarm) Get(c *nuage.Connection) error {
+	if a.ID == "" {
+		err := fmt.Errorf("Alarm Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "alarms/"+a.ID)
+
+	if err != nil {
+		log.Debugf("Alarm Get: Unable to get Alarm with ID: [%s] . Error: %s ", a.ID, err)
+		return err
+	}
+
+	var aa [1]Alarm
+	err = json.Unmarshal(reply, &aa)
+	if err != nil {
+		log.Debugf("Alarm Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*a = aa[0]
+	log.Debugf("Alarm Get: Found Alarm with name: [%s] and ID: [%s]", a.Name, a.ID)
+	return nil
+}
+
+// Alarm list for a given parent: "enterprise", "domain", "l2domain", "vrs" or "vport" and parent ID
+func (as *Alarmslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if !validalarmparent(parenttype) {
+		err := fmt.Errorf("Alarm List: Invalid parent type: [%s]. Must be one of: enterprise, domain, l2domain, vrs, vport", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Alarm List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/alarms")
+
+	if err != nil {
+		log.Debugf("Alarm List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Alarm List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, as)
+
+	if err != nil {
+		log.Debugf("Alarm List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Alarm List: done")
+	return nil
+}
+
+// Acknowledge an Alarm. Caller must initialize the Alarm ID (a.ID)
+func (a *Alarm) Acknowledge(c *nuage.Connection) error {
+	if a.ID == "" {
+		err := fmt.Errorf("Alarm Acknowledge: Empty ID, nothing to do")
+		return err
+	}
+
+	jsonack, _ := json.Marshal(map[string]bool{"acknowledged": true})
+	_, err := nuage.UpdateEntity(c, "alarms/"+a.ID, jsonack)
+
+	if err != nil {
+		log.Debugf("Alarm Acknowledge: Unable to acknowledge Alarm with ID: [%s] . Error: %s ", a.ID, err)
+		return err
+	}
+
+	a.Acknowledged = true
+	log.Debugf("Alarm Acknowledge: Acknowledged Alarm with ID: [%s]", a.ID)
+	return nil
+}
+
+// The Alarms of at least the given severity, e.g. "MAJOR" for both CRITICAL and MAJOR ones. All of them if the severity is empty
+func (as Alarmslice) FilterSeverity(severity string) (Alarmslice, error) {
+	if severity == "" {
+		return as, nil
+	}
+
+	threshold := severityrank(severity)
+	if threshold < 0 {
+		err := fmt.Errorf("Alarm Filter: Invalid severity: [%s]. Must be one of: %s", severity, strings.Join(AlarmSeverities, ", "))
+		return nil, err
+	}
+
+	var filtered Alarmslice
+	for _, a := range as {
+		if rank := severityrank(a.Severity); rank >= 0 && rank <= threshold {
+			filtered = append(filtered, a)
+		}
+	}
+
+	return filtered, nil
+}
+
+// The time an Alarm was raised
+func (a *Alarm) Time() time.Time {
+	return time.Unix(0, a.Timestamp*int64(time.Millisecond))
+}
+
+////////
+//////// Event log methods
+////////
+
+// Event log list for a given parent: "enterprise", "domain", "l2domain", "vrs" or "vport" and parent ID
+func (es *EventLogslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if !validalarmparent(parenttype) {
+		err := fmt.Errorf("Event log List: Invalid parent type: [%s]. Must be one of: enterprise, domain, l2domain, vrs, vport", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Event log List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/eventlogs")
+
+	if err != nil {
+		log.Debugf("Event log List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Event log List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, es)
+
+	if err != nil {
+		log.Debugf("Event log List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Event log List: done")
+	return nil
+}
+
+// The time an Event log was received
+func (e *EventLog) Time() time.Time {
+	return time.Unix(0, e.EventReceivedTime*int64(time.Millisecond))
+}
+
+////////
+//////// Alarms and Event logs -- auxiliary functions. Unexported
+////////
+
+func validalarmparent(parenttype string) bool {
+	switch parenttype {
+	case "enterprise", "domain", "l2domain", "vrs", "vport":
+		return true
+	}
+	return false
+}
+
+// Position in AlarmSeverities -- 0 is the most severe. -1 if unknown
+func severityrank(severity string) int {
+	for i, s := range AlarmSeverities {
+		if s == strings.ToUpper(severity) {
+			return i
+		}
+	}
+	return -1
+}
+
+////////
+//////// Multicast channel map methods
+////////
+
+// Delete by Multicast channel map ID (cm.ID)
+func (cm *MulticastChannelMap) Delete(c *nuage.Connection) error {
+	if cm.ID == "" {
+		err := fmt.Errorf("Multicast channel map Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "multicastchannelmaps", cm.ID)
+
+	if err != nil {
+		log.Debugf("Multicast channel map Delete: Unable to delete Multicast channel map with ID: [%s] . Error: %s ", cm.ID, err)
+		return err
+	}
+
+	log.Debugf("Multicast channel map Delete: Deleted Multicast channel map with ID: [%s] ", cm.ID)
+	return nil
+}
+
+// Create a new Multicast channel map. Assumes the method receiver was allocated using "new(MulticastChannelMap)"
+// Caller must populate:
+// - Name (cm.Name)
+// Address ranges are added afterwards, as MulticastRange children
+func (cm *MulticastChannelMap) Create(c *nuage.Connection) error {
+	if cm == nil {
+		err := fmt.Errorf("Multicast channel map Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if cm.Name == "" {
+		err := fmt.Errorf("Multicast channel map Create: Empty Name, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var cma [1]MulticastChannelMap
+	// XXX - This copies the supplied fields
+	cma[0] = *cm
+
+	jsoncm, _ := json.MarshalIndent(cma[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "multicastchannelmaps", jsoncm)
+
+	if err != nil {
+		log.Debugf("Multicast channel map Create: Unable to create Multicast channel map with name: [%s] . Error: %s ", cm.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &cma)
+
+	if err != nil {
+		log.Debugf("Multicast channel map Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*cm = cma[0]
+	log.Debugf("Multicast channel map Create: Created Multicast channel map with ID: [%s]", cm.ID)
+	return nil
+}
+
+// Get by Multicast channel map ID (cm.ID)
+func (cm *MulticastChannelMap) Get(c *nuage.Connection) error {
+	if cm.ID == "" {
+		err := fmt.Errorf("Multicast channel map Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "multicastchannelmaps/"+cm.ID)
+
+	if err != nil {
+		log.Debugf("Multicast channel map Get: Unable to get Multicast channel map with ID: [%s] . Error: %s ", cm.ID, err)
+		return err
+	}
+
+	var cma [1]MulticastChannelMap
+	err = json.Unmarshal(reply, &cma)
+	if err != nil {
+		log.Debugf("Multicast channel map Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*cm = cma[0]
+	log.Debugf("Multicast channel map Get: Found Multicast channel map with name: [%s] and ID: [%s]", cm.Name, cm.ID)
+	return nil
+}
+
+// Global list of Multicast channel maps
+func (cms *MulticastChannelMapslice) List(c *nuage.Connection) error {
+	reply, err := nuage.GetEntity(c, "multicastchannelmaps")
+
+	if err != nil {
+		log.Debugf("Multicast channel map List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Multicast channel map List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, cms)
+
+	if err != nil {
+		log.Debugf("Multicast channel map List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Multicast channel map List: done")
+	return nil
+}
+
+// Attach a Multicast channel map (by ID) to a VPort: The receive channel map, or the send one if "send" is set. Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) AssociateMulticastChannelMap(c *nuage.Connection, cmid string, send bool) error {
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Associate Multicast channel map: Empty VPort ID, nothing to do")
+		return err
+	}
+
+	if cmid == "" {
+		err := fmt.Errorf("VPort Associate Multicast channel map: Empty Multicast channel map ID, nothing to do")
+		return err
+	}
+
+	attr := "associatedMulticastChannelMapID"
+	if send {
+		attr = "associatedSendMulticastChannelMapID"
+	}
+
+	jsoncm, _ := json.Marshal(map[string]string{attr: cmid})
+	_, err := nuage.UpdateEntity(c, "vports/"+vp.ID, jsoncm)
+
+	if err != nil {
+		log.Debugf("VPort Associate Multicast channel map: Unable to associate Multicast channel map with ID: [%s] to VPort with ID: [%s] . Error: %s ", cmid, vp.ID, err)
+		return err
+	}
+
+	if send {
+		vp.AssociatedSendMulticastChannelMapID = cmid
+	} else {
+		vp.AssociatedMulticastChannelMapID = cmid
+	}
+	log.Debugf("VPort Associate Multicast channel map: Associated Multicast channel map with ID: [%s] to VPort with ID: [%s]", cmid, vp.ID)
+	return nil
+}
+
+// Detach the (receive, or send if "send" is set) Multicast channel map from a VPort. Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) DisassociateMulticastChannelMap(c *nuage.Connection, send bool) error {
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Disassociate Multicast channel map: Empty VPort ID, nothing to do")
+		return err
+	}
+
+	// "null" clears the association. Can't use the VPort itself since the attribute is omitted when empty
+	jsoncm := []byte(`{"associatedMulticastChannelMapID": null}`)
+	if send {
+		jsoncm = []byte(`{"associatedSendMulticastChannelMapID": null}`)
+	}
+	_, err := nuage.UpdateEntity(c, "vports/"+vp.ID, jsoncm)
+
+	if err != nil {
+		log.Debugf("VPort Disassociate Multicast channel map: Unable to disassociate Multicast channel map from VPort with ID: [%s] . Error: %s ", vp.ID, err)
+		return err
+	}
+
+	if send {
+		vp.AssociatedSendMulticastChannelMapID = ""
+	} else {
+		vp.AssociatedMulticastChannelMapID = ""
+	}
+	log.Debugf("VPort Disassociate Multicast channel map: Disassociated Multicast channel map from VPort with ID: [%s]", vp.ID)
+	return nil
+}
+
+////////
+//////// Multicast range methods
+////////
+
+// Delete by Multicast range ID (mr.ID)
+func (mr *MulticastRange) Delete(c *nuage.Connection) error {
+	if mr.ID == "" {
+		err := fmt.Errorf("Multicast range Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "multicastranges", mr.ID)
+
+	if err != nil {
+		log.Debugf("Multicast range Delete: Unable to delete Multicast range with ID: [%s] . Error: %s ", mr.ID, err)
+		return err
+	}
+
+	log.Debugf("Multicast range Delete: Deleted Multicast range with ID: [%s] ", mr.ID)
+	return nil
+}
+
+// Create a new Multicast range. Assumes the method receiver was allocated using "new(MulticastRange)"
+// Caller must populate:
+// - Parent Multicast channel map ID (mr.ParentID)
+// - Lowest and highest multicast group addresses (mr.MinAddress, mr.MaxAddress), e.g. 239.1.1.1 - 239.1.1.254
+func (mr *MulticastRange) Create(c *nuage.Connection) error {
+	if mr == nil {
+		err := fmt.Errorf("Multicast range Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if mr.ParentID == "" {
+		err := fmt.Errorf("Multicast range Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if err := validmulticastrange(mr.MinAddress, mr.MaxAddress); err != nil {
+		return fmt.Errorf("Multicast range Create: %s", err)
+	}
+
+	if mr.IPType == "" {
+		mr.IPType = "IPV4"
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var mra [1]MulticastRange
+	// XXX - This copies the supplied fields
+	mra[0] = *mr
+
+	jsonmr, _ := json.MarshalIndent(mra[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "multicastchannelmaps/"+mr.ParentID+"/multicastranges", jsonmr)
+
+	if err != nil {
+		log.Debugf("Multicast range Create: Unable to create Multicast range: [%s - %s] . Error: %s ", mr.MinAddress, mr.MaxAddress, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &mra)
+
+	if err != nil {
+		log.Debugf("Multicast range Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*mr = mra[0]
+	log.Debugf("Multicast range Create: Created Multicast range with ID: [%s]", mr.ID)
+	return nil
+}
+
+// Get by Multicast range ID (mr.ID)
+func (mr *MulticastRange) Get(c *nuage.Connection) error {
+	if mr.ID == "" {
+		err := fmt.Errorf("Multicast range Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "multicastranges/"+mr.ID)
+
+	if err != nil {
+		log.Debugf("Multicast range Get: Unable to get Multicast range with ID: [%s] . Error: %s ", mr.ID, err)
+		return err
+	}
+
+	var mra [1]MulticastRange
+	err = json.Unmarshal(reply, &mra)
+	if err != nil {
+		log.Debugf("Multicast range Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*mr = mra[0]
+	log.Debugf("Multicast range Get: Found Multicast range with ID: [%s]", mr.ID)
+	return nil
+}
+
+// Multicast range list for a given Multicast channel map ID
+func (mrs *MulticastRangeslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Multicast range List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "multicastchannelmaps/"+parentid+"/multicastranges")
+
+	if err != nil {
+		log.Debugf("Multicast range List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Multicast range List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, mrs)
+
+	if err != nil {
+		log.Debugf("Multicast range List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Multicast range List: done")
+	return nil
+}
+
+////////
+//////// Multicast list methods
+////////
+
+// Delete by Multicast list ID (ml.ID)
+func (ml *MulticastList) Delete(c *nuage.Connection) error {
+	if ml.ID == "" {
+		err := fmt.Errorf("Multicast list Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "multicastlists", ml.ID)
+
+	if err != nil {
+		log.Debugf("Multicast list Delete: Unable to delete Multicast list with ID: [%s] . Error: %s ", ml.ID, err)
+		return err
+	}
+
+	log.Debugf("Multicast list Delete: Deleted Multicast list with ID: [%s] ", ml.ID)
+	return nil
+}
+
+// Create a new Multicast list. Assumes the method receiver was allocated using "new(MulticastList)"
+// Caller must populate:
+// - Parent Enterprise ID (ml.ParentID)
+// - Optionally: ml.Unlimited, to allow all channels. Otherwise only those of its Channel maps -- see "AssignChannelMaps"
+func (ml *MulticastList) Create(c *nuage.Connection) error {
+	if ml == nil {
+		err := fmt.Errorf("Multicast list Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if ml.ParentID == "" {
+		err := fmt.Errorf("Multicast list Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var mla [1]MulticastList
+	// XXX - This copies the supplied fields
+	mla[0] = *ml
+
+	jsonml, _ := json.MarshalIndent(mla[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+ml.ParentID+"/multicastlists", jsonml)
+
+	if err != nil {
+		log.Debugf("Multicast list Create: Unable to create Multicast list for Enterprise with ID: [%s] . Error: %s ", ml.ParentID, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &mla)
+
+	if err != nil {
+		log.Debugf("Multicast list Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*ml = mla[0]
+	log.Debugf("Multicast list Create: Created Multicast list with ID: [%s]", ml.ID)
+	return nil
+}
+
+// Get by Multicast list ID (ml.ID)
+func (ml *MulticastList) Get(c *nuage.Connection) error {
+	if ml.ID == "" {
+		err := fmt.Errorf("Multicast list Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "multicastlists/"+ml.ID)
+
+	if err != nil {
+		log.Debugf("Multicast list Get: Unable to get Multicast list with ID: [%s] . Error: %s ", ml.ID, err)
+		return err
+	}
+
+	var mla [1]MulticastList
+	err = json.Unmarshal(reply, &mla)
+	if err != nil {
+		log.Debugf("Multicast list Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*ml = mla[0]
+	log.Debugf("Multicast list Get: Found Multicast list with ID: [%s]", ml.ID)
+	return nil
+}
+
+// Multicast list list for a given Enterprise ID
+func (mls *MulticastListslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Multicast list List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/multicastlists")
+
+	if err != nil {
+		log.Debugf("Multicast list List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Multicast list List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, mls)
+
+	if err != nil {
+		log.Debugf("Multicast list List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Multicast list List: done")
+	return nil
+}
+
+// Channel maps list for a Multicast list.  Caller must initialize the Multicast list ID (ml.ID)
+func (ml *MulticastList) ChannelMapsList(c *nuage.Connection) ([]MulticastChannelMap, error) {
+
+	if ml.ID == "" {
+		err := fmt.Errorf("Multicast list Channel maps List: Empty Multicast list ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "multicastlists/"+ml.ID+"/multicastchannelmaps")
+
+	if err != nil {
+		log.Debugf("Multicast list Channel maps List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Multicast list Channel maps List: Empty list")
+		return nil, nil
+	}
+
+	var cms []MulticastChannelMap
+
+	err = json.Unmarshal(reply, &cms)
+	if err != nil {
+		log.Debugf("Multicast list Channel maps List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Multicast list Channel maps List: done")
+	return cms, nil
+
+}
+
+// Multicast list Channel maps: Add Channel maps (by ID) to a Multicast list. Caller must initialize the Multicast list ID (ml.ID)
+func (ml *MulticastList) AssignChannelMaps(c *nuage.Connection, cmids ...string) error {
+	if ml.ID == "" {
+		err := fmt.Errorf("Multicast list Assign Channel maps: Empty Multicast list ID, nothing to do")
+		return err
+	}
+
+	members, err := ml.ChannelMapsList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, cm := range members {
+		ids = append(ids, cm.ID)
+	}
+
+	return assignmembers(c, "Multicast list Assign Channel maps", "multicastlists/"+ml.ID+"/multicastchannelmaps", ids, cmids)
+}
+
+// Multicast list Channel maps: Remove Channel maps (by ID) from a Multicast list. Caller must initialize the Multicast list ID (ml.ID)
+func (ml *MulticastList) UnassignChannelMaps(c *nuage.Connection, cmids ...string) error {
+	if ml.ID == "" {
+		err := fmt.Errorf("Multicast list Unassign Channel maps: Empty Multicast list ID, nothing to do")
+		return err
+	}
+
+	members, err := ml.ChannelMapsList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, cm := range members {
+		ids = append(ids, cm.ID)
+	}
+
+	return unassignmembers(c, "Multicast list Unassign Channel maps", "multicastlists/"+ml.ID+"/multicastchannelmaps", ids, cmids)
+}
+
+////////
+//////// Multicast -- auxiliary functions. Unexported
+////////
+
+// Both addresses IPv4 multicast (224.0.0.0/4), lowest first
+func validmulticastrange(min, max string) error {
+	lo := net.ParseIP(min).To4()
+	hi := net.ParseIP(max).To4()
+
+	if lo == nil || !lo.IsMulticast() {
+		return fmt.Errorf("Invalid multicast address: [%s]", min)
+	}
+
+	if hi == nil || !hi.IsMulticast() {
+		return fmt.Errorf("Invalid multicast address: [%s]", max)
+	}
+
+	for i := range lo {
+		if lo[i] != hi[i] {
+			if lo[i] > hi[i] {
+				return fmt.Errorf("Invalid multicast range: [%s] is higher than [%s]", min, max)
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+////////
+//////// QoS methods
+////////
+
+// Delete by QoS ID (q.ID)
+func (q *QoS) Delete(c *nuage.Connection) error {
+	if q.ID == "" {
+		err := fmt.Errorf("QoS Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "qos", q.ID)
+
+	if err != nil {
+		log.Debugf("QoS Delete: Unable to delete QoS with ID: [%s] . Error: %s ", q.ID, err)
+		return err
+	}
+
+	log.Debugf("QoS Delete: Deleted QoS with ID: [%s] ", q.ID)
+	return nil
+}
+
+// Create a new QoS. Assumes the method receiver was allocated using "new(QoS)"
+// Caller must populate:
+// - Name (q.Name)
+// - Parent type (q.ParentType): "domain", "zone", "subnet", "vport" or "l2domain"
+// - Parent ID (q.ParentID)
+// - Rates (Mbps) and burst sizes (KB), e.g. using "ParseRate" / "ParseBurst". Rate limiting is active if a Peak rate is set
+func (q *QoS) Create(c *nuage.Connection) error {
+	if q == nil {
+		err := fmt.Errorf("QoS Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if q.Name == "" {
+		err := fmt.Errorf("QoS Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if !validqosparent(q.ParentType) {
+		err := fmt.Errorf("QoS Create: Invalid parent type: [%s]. Must be one of: domain, zone, subnet, vport, l2domain", q.ParentType)
+		return err
+	}
+
+	if q.ParentID == "" {
+		err := fmt.Errorf("QoS Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if err := validqos(q); err != nil {
+		return fmt.Errorf("QoS Create: %s", err)
+	}
+
+	if q.Peak != "" {
+		q.RateLimitingActive = true
+	}
+
+	if q.FIPPeakInformationRate != "" {
+		q.FIPRateLimitingActive = true
+	}
+
+	q.Active = true
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var qa [1]QoS
+	// XXX - This copies the supplied fields
+	qa[0] = *q
+
+	jsonq, _ := json.MarshalIndent(qa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, q.ParentType+"s/"+q.ParentID+"/qos", jsonq)
+
+	if err != nil {
+		log.Debugf("QoS Create: Unable to create QoS with name: [%s] . Error: %s ", q.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &qa)
+
+	if err != nil {
+		log.Debugf("QoS Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*q = qa[0]
+	log.Debugf("QoS Create: Created QoS with ID: [%s]", q.ID)
+	return nil
+}
+
+// Get by QoS ID (q.ID)
+func (q *QoS) Get(c *nuage.Connection) error {
+	if q.ID == "" {
+		err := fmt.Errorf("QoS Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "qos/"+q.ID)
+
+	if err != nil {
+		log.Debugf("QoS Get: Unable to get QoS with ID: [%s] . Error: %s ", q.ID, err)
+		return err
+	}
+
+	var qa [1]QoS
+	err = json.Unmarshal(reply, &qa)
+	if err != nil {
+		log.Debugf("QoS Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*q = qa[0]
+	log.Debugf("QoS Get: Found QoS with name: [%s] and ID: [%s]", q.Name, q.ID)
+	return nil
+}
+
+// Update an existing QoS, e.g. its rates. Caller must initialize the QoS ID (q.ID)
+func (q *QoS) Update(c *nuage.Connection) error {
+	if q.ID == "" {
+		err := fmt.Errorf("QoS Update: Empty ID, nothing to do")
+		return err
+	}
+
+	if err := validqos(q); err != nil {
+		return fmt.Errorf("QoS Update: %s", err)
+	}
+
+	jsonq, _ := json.MarshalIndent(q, "", "\t")
+	_, err := nuage.UpdateEntity(c, "qos/"+q.ID, jsonq)
+
+	if err != nil {
+		log.Debugf("QoS Update: Unable to update QoS with ID: [%s] . Error: %s ", q.ID, err)
+		return err
+	}
+
+	log.Debugf("QoS Update: Updated QoS with name: [%s] and ID: [%s]", q.Name, q.ID)
+	return nil
+}
+
+// QoS list for a given parent: "domain", "zone", "subnet", "vport" or "l2domain" and parent ID
+func (qs *QoSslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if !validqosparent(parenttype) {
+		err := fmt.Errorf("QoS List: Invalid parent type: [%s]. Must be one of: domain, zone, subnet, vport, l2domain", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("QoS List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/qos")
+
+	if err != nil {
+		log.Debugf("QoS List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("QoS List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, qs)
+
+	if err != nil {
+		log.Debugf("QoS List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("QoS List: done")
+	return nil
+}
+
+// Copy the rates and burst size of a Rate limiter into the QoS (q.Peak, q.Burst, q.CommittedInformationRate). Does not update the VSD
+func (q *QoS) ApplyRateLimiter(rl RateLimiter) {
+	q.Peak = rl.PeakInformationRate
+	q.Burst = rl.PeakBurstSize
+	q.CommittedInformationRate = rl.CommittedInformationRate
+	q.RateLimitingActive = q.Peak != ""
+}
+
+// The QoS in effect for a VPort: The first active QoS found walking up the hierarchy
+// (VPort, Subnet, Zone, Domain -- or VPort, L2 Domain). Returns nil if none. Its ParentType / ParentID record where it was found
+func (vp *VPort) EffectiveQoS(c *nuage.Connection) (*QoS, error) {
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Effective QoS: Empty VPort ID, nothing to do")
+		return nil, err
+	}
+
+	chain, err := vp.parentchain(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range chain {
+		var qs QoSslice
+		if err := qs.List(c, p.ptype, p.pid); err != nil {
+			return nil, err
+		}
+		for _, q := range qs {
+			if !q.Active {
+				continue
+			}
+			// Make sure the source is always recorded
+			q.ParentType = p.ptype
+			q.ParentID = p.pid
+			log.Debugf("VPort Effective QoS: Found QoS with name: [%s] on %s with ID: [%s] for VPort with ID: [%s]", q.Name, p.ptype, p.pid, vp.ID)
+			return &q, nil
+		}
+	}
+
+	log.Debugf("VPort Effective QoS: No QoS for VPort with ID: [%s]", vp.ID)
+	return nil, nil
+}
+
+////////
+//////// Rate limiter methods
+////////
+
+// Delete by Rate limiter ID (rl.ID)
+func (rl *RateLimiter) Delete(c *nuage.Connection) error {
+	if rl.ID == "" {
+		err := fmt.Errorf("Rate limiter Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "ratelimiters", rl.ID)
+
+	if err != nil {
+		log.Debugf("Rate limiter Delete: Unable to delete Rate limiter with ID: [%s] . Error: %s ", rl.ID, err)
+		return err
+	}
+
+	log.Debugf("Rate limiter Delete: Deleted Rate limiter with ID: [%s] ", rl.ID)
+	return nil
+}
+
+// Create a new Rate limiter. Assumes the method receiver was allocated using "new(RateLimiter)"
+// Caller must populate:
+// - Name (rl.Name)
+// - Parent Enterprise ID (rl.ParentID)
+// - Peak rate (rl.PeakInformationRate, Mbps) and burst size (rl.PeakBurstSize, KB). Optionally: committed rate (rl.CommittedInformationRate, Mbps)
+func (rl *RateLimiter) Create(c *nuage.Connection) error {
+	if rl == nil {
+		err := fmt.Errorf("Rate limiter Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if rl.Name == "" {
+		err := fmt.Errorf("Rate limiter Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if rl.ParentID == "" {
+		err := fmt.Errorf("Rate limiter Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if rl.PeakInformationRate == "" || rl.PeakBurstSize == "" {
+		err := fmt.Errorf("Rate limiter Create: Empty peak rate or burst size, nothing to do")
+		return err
+	}
+
+	if err := validratepair(rl.PeakInformationRate, rl.CommittedInformationRate); err != nil {
+		return fmt.Errorf("Rate limiter Create: %s", err)
+	}
+
+	if !validqosburst(rl.PeakBurstSize) {
+		err := fmt.Errorf("Rate limiter Create: Invalid burst size: [%s]. Must be a positive integer, in KB", rl.PeakBurstSize)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var rla [1]RateLimiter
+	// XXX - This copies the supplied fields
+	rla[0] = *rl
+
+	jsonrl, _ := json.MarshalIndent(rla[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+rl.ParentID+"/ratelimiters", jsonrl)
+
+	if err != nil {
+		log.Debugf("Rate limiter Create: Unable to create Rate limiter with name: [%s] . Error: %s ", rl.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &rla)
+
+	if err != nil {
+		log.Debugf("Rate limiter Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*rl = rla[0]
+	log.Debugf("Rate limiter Create: Created Rate limiter with ID: [%s]", rl.ID)
+	return nil
+}
+
+// Get by Rate limiter ID (rl.ID)
+func (rl *RateLimiter) Get(c *nuage.Connection) error {
+	if rl.ID == "" {
+		err := fmt.Errorf("Rate limiter Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ratelimiters/"+rl.ID)
+
+	if err != nil {
+		log.Debugf("Rate limiter Get: Unable to get Rate limiter with ID: [%s] . Error: %s ", rl.ID, err)
+		return err
+	}
+
+	var rla [1]RateLimiter
+	err = json.Unmarshal(reply, &rla)
+	if err != nil {
+		log.Debugf("Rate limiter Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*rl = rla[0]
+	log.Debugf("Rate limiter Get: Found Rate limiter with name: [%s] and ID: [%s]", rl.Name, rl.ID)
+	return nil
+}
+
+// Rate limiter list for a given Enterprise ID
+func (rls *RateLimiterslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Rate limiter List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/ratelimiters")
+
+	if err != nil {
+		log.Debugf("Rate limiter List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Rate limiter List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, rls)
+
+	if err != nil {
+		log.Debugf("Rate limiter List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Rate limiter List: done")
+	return nil
+}
+
+////////
+//////// QoS -- rate and burst size unit conversions
+////////
+
+// Convert a human readable rate to Mbps, as expected by the VSD. Units: bps, kbps, Mbps (default), Gbps -- case insensitive. "INFINITY" (or "inf") for no limit
+// E.g. "1.5Gbps" -> "1500", "512kbps" -> "0.512", "100" -> "100"
+func ParseRate(rate string) (string, error) {
+	s := strings.ToUpper(strings.TrimSpace(rate))
+
+	if s == "INFINITY" || s == "INF" {
+		return "INFINITY", nil
+	}
+
+	mult := 1.0
+	for _, u := range []struct {
+		suffix string
+		mult   float64
+	}{{"GBPS", 1000}, {"MBPS", 1}, {"KBPS", 0.001}, {"BPS", 0.000001}} {
+		if strings.HasSuffix(s, u.suffix) {
+			s = strings.TrimSuffix(s, u.suffix)
+			mult = u.mult
+			break
+		}
+	}
+
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || !finiterate(v) {
+		return "", fmt.Errorf("Invalid rate: [%s]. Expecting e.g. 100Mbps, 1.5Gbps, 512kbps or INFINITY", rate)
+	}
+
+	return strconv.FormatFloat(v*mult, 'f', -1, 64), nil
+}
+
+// Convert a human readable burst size to KB, as expected by the VSD. Units: KB (default), MB, GB -- case insensitive
+// E.g. "1MB" -> "1024", "100" -> "100"
+func ParseBurst(burst string) (string, error) {
+	s := strings.ToUpper(strings.TrimSpace(burst))
+
+	mult := 1
+	for _, u := range []struct {
+		suffix string
+		mult   int
+	}{{"GB", 1024 * 1024}, {"MB", 1024}, {"KB", 1}} {
+		if strings.HasSuffix(s, u.suffix) {
+			s = strings.TrimSuffix(s, u.suffix)
+			mult = u.mult
+			break
+		}
+	}
+
+	v, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || v <= 0 {
+		return "", fmt.Errorf("Invalid burst size: [%s]. Expecting a positive integer, e.g. 100KB, 1MB", burst)
+	}
+
+	return strconv.Itoa(v * mult), nil
+}
+
+////////
+//////// QoS -- auxiliary functions. Unexported
+////////
+
+func validqosparent(parenttype string) bool {
+	switch parenttype {
+	case "domain", "zone", "subnet", "vport", "l2domain":
+		return true
+	}
+	return false
+}
+
+// Empty (unset), "INFINITY" or a non-negative number of Mbps
+func validqosrate(rate string) bool {
+	if rate == "" || rate == "INFINITY" {
+		return true
+	}
+	v, err := strconv.ParseFloat(rate, 64)
+	return err == nil && finiterate(v)
+}
+
+// Non-negative and finite. "strconv.ParseFloat" also accepts "NaN" and "Inf", which the VSD does not
+func finiterate(v float64) bool {
+	return v >= 0 && !math.IsInf(v, 0)
+}
+
+// Empty (unset) or a positive integer number of KB
+func validqosburst(burst string) bool {
+	if burst == "" {
+		return true
+	}
+	v, err := strconv.Atoi(burst)
+	return err == nil && v > 0
+}
+
+// Both rates valid, and the committed rate no higher than the peak rate
+func validratepair(peak, committed string) error {
+	if !validqosrate(peak) {
+		return fmt.Errorf("Invalid peak rate: [%s]. Must be a number of Mbps or \"INFINITY\"", peak)
+	}
+
+	if !validqosrate(committed) {
+		return fmt.Errorf("Invalid committed rate: [%s]. Must be a number of Mbps or \"INFINITY\"", committed)
+	}
+
+	if peak == "" || peak == "INFINITY" || committed == "" {
+		return nil
+	}
+
+	if committed == "INFINITY" {
+		return fmt.Errorf("Committed rate: [INFINITY] higher than peak rate: [%s]", peak)
+	}
+
+	p, _ := strconv.ParseFloat(peak, 64)
+	cir, _ := strconv.ParseFloat(committed, 64)
+	if cir > p {
+		return fmt.Errorf("Committed rate: [%s] higher than peak rate: [%s]", committed, peak)
+	}
+
+	return nil
+}
+
+func validqos(q *QoS) error {
+	if err := validratepair(q.Peak, q.CommittedInformationRate); err != nil {
+		return err
+	}
+
+	if err := validratepair(q.FIPPeakInformationRate, q.FIPCommittedInformationRate); err != nil {
+		return fmt.Errorf("FIP: %s", err)
+	}
+
+	for _, b := range []string{q.Burst, q.CommittedBurstSize, q.FIPPeakBurstSize, q.FIPCommittedBurstSize} {
+		if !validqosburst(b) {
+			return fmt.Errorf("Invalid burst size: [%s]. Must be a positive integer, in KB", b)
+		}
+	}
+
+	return nil
+}
+
+////////
+//////// Metadata methods. Parent type is the entity type, singular, e.g. "domain", "vport", "enterprise"
+////////
+
+// Delete by Metadata ID (md.ID). Set md.Global for global metadata
+func (md *Metadata) Delete(c *nuage.Connection) error {
+	if md.ID == "" {
+		err := fmt.Errorf("Metadata Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, metadatacollection(md.Global), md.ID)
+
+	if err != nil {
+		log.Debugf("Metadata Delete: Unable to delete Metadata with ID: [%s] . Error: %s ", md.ID, err)
+		return err
+	}
+
+	log.Debugf("Metadata Delete: Deleted Metadata with ID: [%s] ", md.ID)
+	return nil
+}
+
+// Create a new Metadata. Assumes the method receiver was allocated using "new(Metadata)"
+// Caller must populate:
+// - Name (md.Name) -- the key
+// - Blob (md.Blob) -- the value
+// - Parent type (md.ParentType), e.g. "domain"
+// - Parent ID (md.ParentID)
+// - Optionally: md.Global for global metadata
+func (md *Metadata) Create(c *nuage.Connection) error {
+	if md == nil {
+		err := fmt.Errorf("Metadata Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if md.Name == "" {
+		err := fmt.Errorf("Metadata Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if md.ParentType == "" || md.ParentID == "" {
+		err := fmt.Errorf("Metadata Create: Empty ParentType or ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var mda [1]Metadata
+	// XXX - This copies the supplied fields
+	mda[0] = *md
+
+	jsonmd, _ := json.MarshalIndent(mda[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, md.ParentType+"s/"+md.ParentID+"/"+metadatacollection(md.Global), jsonmd)
+
+	if err != nil {
+		log.Debugf("Metadata Create: Unable to create Metadata with name: [%s] . Error: %s ", md.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &mda)
+
+	if err != nil {
+		log.Debugf("Metadata Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*md = mda[0]
+	log.Debugf("Metadata Create: Created Metadata with name: [%s] and ID: [%s]", md.Name, md.ID)
+	return nil
+}
+
+// Get by Metadata ID (md.ID). Set md.Global for global metadata
+func (md *Metadata) Get(c *nuage.Connection) error {
+	if md.ID == "" {
+		err := fmt.Errorf("Metadata Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, metadatacollection(md.Global)+"/"+md.ID)
+
+	if err != nil {
+		log.Debugf("Metadata Get: Unable to get Metadata with ID: [%s] . Error: %s ", md.ID, err)
+		return err
+	}
+
+	var mda [1]Metadata
+	err = json.Unmarshal(reply, &mda)
+	if err != nil {
+		log.Debugf("Metadata Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*md = mda[0]
+	log.Debugf("Metadata Get: Found Metadata with name: [%s] and ID: [%s]", md.Name, md.ID)
+	return nil
+}
+
+// Update the value (md.Blob) of an existing Metadata. Caller must initialize the Metadata ID (md.ID)
+func (md *Metadata) Update(c *nuage.Connection) error {
+	if md.ID == "" {
+		err := fmt.Errorf("Metadata Update: Empty ID, nothing to do")
+		return err
+	}
+
+	jsonmd, _ := json.Marshal(map[string]string{"blob": md.Blob})
+	_, err := nuage.UpdateEntity(c, metadatacollection(md.Global)+"/"+md.ID, jsonmd)
+
+	if err != nil {
+		log.Debugf("Metadata Update: Unable to update Metadata with ID: [%s] . Error: %s ", md.ID, err)
+		return err
+	}
+
+	log.Debugf("Metadata Update: Updated Metadata with name: [%s] and ID: [%s]", md.Name, md.ID)
+	return nil
+}
+
+// Metadata list for a given parent type (e.g. "domain") and parent ID. Global metadata if "global" is set
+func (mds *Metadataslice) List(c *nuage.Connection, parenttype, parentid string, global bool) error {
+	if parenttype == "" || parentid == "" {
+		err := fmt.Errorf("Metadata List: Empty parent type or parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/"+metadatacollection(global))
+
+	if err != nil {
+		log.Debugf("Metadata List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Metadata List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, mds)
+
+	if err != nil {
+		log.Debugf("Metadata List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// Make sure the flag is always set, whatever the VSD returns
+	for i := range *mds {
+		(*mds)[i].Global = global
+	}
+
+	log.Debug("Metadata List: done")
+	return nil
+}
+
+////////
+//////// Tags -- key / value Metadata, on any entity
+////////
+
+// All the tags (Metadata, global and not) of an entity: Parent type (e.g. "domain") and ID
+func Tags(c *nuage.Connection, parenttype, parentid string) ([]Metadata, error) {
+	var mds, gmds Metadataslice
+
+	if err := mds.List(c, parenttype, parentid, false); err != nil {
+		return nil, err
+	}
+
+	if err := gmds.List(c, parenttype, parentid, true); err != nil {
+		return nil, err
+	}
+
+	return append(mds, gmds...), nil
+}
+
+// Tag an entity: Parent type (e.g. "domain") and ID, with key = value. Replaces the value if the key already exists
+func Tag(c *nuage.Connection, parenttype, parentid, key, value string) error {
+	if key == "" {
+		err := fmt.Errorf("Tag: Empty key, nothing to do")
+		return err
+	}
+
+	var mds Metadataslice
+	if err := mds.List(c, parenttype, parentid, false); err != nil {
+		return err
+	}
+
+	for _, md := range mds {
+		if md.Name == key {
+			md.Blob = value
+			return md.Update(c)
+		}
+	}
+
+	md := new(Metadata)
+	md.Name = key
+	md.Blob = value
+	md.ParentType = parenttype
+	md.ParentID = parentid
+	return md.Create(c)
+}
+
+// Untag an entity: Parent type (e.g. "domain") and ID. Removes the key, from both metadata and global metadata
+func Untag(c *nuage.Connection, parenttype, parentid, key string) error {
+	mds, err := Tags(c, parenttype, parentid)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, md := range mds {
+		if md.Name == key {
+			if err := md.Delete(c); err != nil {
+				return err
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		err := fmt.Errorf("Untag: No tag with key: [%s] on %s with ID: [%s]", key, parenttype, parentid)
+		return err
+	}
+
+	return nil
+}
+
+// Find the entities of a given type (e.g. "domain") tagged with key = value. With an empty value, any entity having the key.
+// OBS: No server side filtering -- this walks the (global) list of entities of that type and checks the tags of each
+func FindTagged(c *nuage.Connection, entitytype, key, value string) ([]TaggedEntity, error) {
+	reply, err := nuage.GetEntity(c, entitytype+"s")
+
+	if err != nil {
+		log.Debugf("Find Tagged: Unable to obtain list of %ss: %s ", entitytype, err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Find Tagged: Empty list")
+		return nil, nil
+	}
+
+	// We only need the ID and the name of the entities, whatever their type
+	var entities []struct {
+		ID       string `json:"ID"`
+		Name     string `json:"name"`
+		UserName string `json:"userName"`
+	}
+
+	err = json.Unmarshal(reply, &entities)
+	if err != nil {
+		log.Debugf("Find Tagged: Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	var tagged []TaggedEntity
+
+	for _, e := range entities {
+		mds, err := Tags(c, entitytype, e.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, md := range mds {
+			if md.Name == key && (value == "" || md.Blob == value) {
+				name := e.Name
+				if name == "" {
+					name = e.UserName
+				}
+				tagged = append(tagged, TaggedEntity{Type: entitytype, ID: e.ID, Name: name, Metadata: mds})
+				break
+			}
+		}
+	}
+
+	log.Debugf("Find Tagged: Found [%d] %ss out of [%d] tagged with: [%s=%s]", len(tagged), entitytype, len(entities), key, value)
+	return tagged, nil
+}
+
+////////
+//////// Metadata -- auxiliary functions. Unexported
+////////
+
+func metadatacollection(global bool) string {
+	if global {
+		return "globalmetadatas"
+	}
+	return "metadatas"
+}
+
+////////
+//////// Statistics methods
+////////
+
+// Statistics for a VPort.  Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) Statistics(c *nuage.Connection, q StatisticsQuery) (Statistics, error) {
+	return getstatistics(c, "vport", vp.ID, q)
+}
+
+// Statistics for a Subnet.  Caller must initialize the Subnet ID (s.ID)
+func (s *Subnet) Statistics(c *nuage.Connection, q StatisticsQuery) (Statistics, error) {
+	return getstatistics(c, "subnet", s.ID, q)
+}
+
+// Statistics for a Zone.  Caller must initialize the Zone ID (z.ID)
+func (z *Zone) Statistics(c *nuage.Connection, q StatisticsQuery) (Statistics, error) {
+	return getstatistics(c, "zone", z.ID, q)
+}
+
+// Statistics for a Domain.  Caller must initialize the Domain ID (d.ID)
+func (d *Domain) Statistics(c *nuage.Connection, q StatisticsQuery) (Statistics, error) {
+	return getstatistics(c, "domain", d.ID, q)
+}
+
+// Timestamp of the i-th data point
+func (st *Statistics) Timestamp(i int) time.Time {
+	if st.NumberOfDataPoints <= 0 {
+		return time.Unix(st.StartTime, 0)
+	}
+	step := (st.EndTime - st.StartTime) / int64(st.NumberOfDataPoints)
+	return time.Unix(st.StartTime+int64(i)*step, 0)
+}
+
+////////
+//////// Statistics -- auxiliary functions. Unexported
+////////
+
+func getstatistics(c *nuage.Connection, parenttype, parentid string, q StatisticsQuery) (Statistics, error) {
+	var st Statistics
+
+	if parentid == "" {
+		err := fmt.Errorf("Statistics: Empty %s ID, nothing to do", parenttype)
+		return st, err
+	}
+
+	if q.End.IsZero() {
+		q.End = time.Now()
+	}
+
+	if q.Start.IsZero() || !q.Start.Before(q.End) {
+		err := fmt.Errorf("Statistics: Invalid time range: [%s] - [%s]", q.Start.Format(time.RFC3339), q.End.Format(time.RFC3339))
+		return st, err
+	}
+
+	if q.Granularity <= 0 || q.Granularity > q.End.Sub(q.Start) {
+		err := fmt.Errorf("Statistics: Invalid granularity: [%s] for time range of: [%s]", q.Granularity, q.End.Sub(q.Start))
+		return st, err
+	}
+
+	if len(q.Metrics) == 0 {
+		q.Metrics = StatisticsMetrics
+	}
+
+	points := int(q.End.Sub(q.Start) / q.Granularity)
+
+	query := fmt.Sprintf("?startTime=%d&endTime=%d&numberOfDataPoints=%d&metricTypes=%s", q.Start.Unix(), q.End.Unix(), points, strings.Join(q.Metrics, ","))
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/statistics"+query)
+
+	if err != nil {
+		log.Debugf("Statistics: Unable to get statistics for %s with ID: [%s] . Error: %s ", parenttype, parentid, err)
+		return st, err
+	}
+
+	// Some VSD releases return the object, some an array of one
+	var sta [1]Statistics
+	if err = json.Unmarshal(reply, &sta); err != nil {
+		if err = json.Unmarshal(reply, &sta[0]); err != nil {
+			log.Debugf("Statistics: Unable to decode JSON payload: %s ", err)
+			return st, err
+		}
+	}
+
+	st = sta[0]
+	if st.StartTime == 0 {
+		st.StartTime, st.EndTime, st.NumberOfDataPoints = q.Start.Unix(), q.End.Unix(), points
+	}
+
+	log.Debugf("Statistics: Got [%d] metrics with [%d] data points for %s with ID: [%s]", len(st.Stats), st.NumberOfDataPoints, parenttype, parentid)
+	return st, nil
+}
+
+////////
+//////// Job methods
+////////
+
+// How often "Wait" polls a running Job
+var JobPollInterval = 2 * time.Second
+
+// Submit a new Job. Assumes the method receiver was allocated using "new(Job)"
+// Caller must populate:
+// - Command (j.Command), e.g. "EXPORT"
+// - Parent type (j.ParentType), e.g. "domain", "enterprise", "vm"
+// - Parent ID (j.ParentID)
+// - Optionally: Command parameters (j.Parameters)
+func (j *Job) Submit(c *nuage.Connection) error {
+	if j == nil {
+		err := fmt.Errorf("Job Submit: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if j.Command == "" {
+		err := fmt.Errorf("Job Submit: Empty Command, nothing to do")
+		return err
+	}
+
+	if j.ParentType == "" || j.ParentID == "" {
+		err := fmt.Errorf("Job Submit: Empty ParentType or ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var ja [1]Job
+	ja[0] = *j
+
+	jsonjob, _ := json.MarshalIndent(ja[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, j.ParentType+"s/"+j.ParentID+"/jobs", jsonjob)
+
+	if err != nil {
+		log.Debugf("Job Submit: Unable to submit Job with command: [%s] . Error: %s ", j.Command, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &ja)
+
+	if err != nil {
+		log.Debugf("Job Submit: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*j = ja[0]
+	log.Debugf("Job Submit: Submitted Job with command: [%s] and ID: [%s]", j.Command, j.ID)
+	return nil
+}
+
+// Get by Job ID (j.ID)
+func (j *Job) Get(c *nuage.Connection) error {
+	if j.ID == "" {
+		err := fmt.Errorf("Job Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "jobs/"+j.ID)
+
+	if err != nil {
+		log.Debugf("Job Get: Unable to get Job with ID: [%s] . Error: %s ", j.ID, err)
+		return err
+	}
+
+	var ja [1]Job
+	err = json.Unmarshal(reply, &ja)
+	if err != nil {
+		log.Debugf("Job Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*j = ja[0]
+	log.Debugf("Job Get: Job with ID: [%s] command: [%s] status: [%s] progress: [%.0f%%]", j.ID, j.Command, j.Status, 100*j.Progress)
+	return nil
+}
+
+// Job list for a given parent type (e.g. "domain") and parent ID
+func (js *Jobslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parenttype == "" || parentid == "" {
+		err := fmt.Errorf("Job List: Empty parent type or parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/jobs")
+
+	if err != nil {
+		log.Debugf("Job List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Job List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, js)
+
+	if err != nil {
+		log.Debugf("Job List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Job List: done")
+	return nil
+}
+
+// Whether the Job is finished, successfully or not
+func (j *Job) Done() bool {
+	return j.Status == "SUCCESS" || j.Status == "FAILED"
+}
+
+// Poll the Job (by ID, j.ID) until it finishes, or until "timeout" expires (no timeout if 0).
+// If not nil, "progress" is called with the Job after every poll. Returns an error if the Job failed or timed out
+func (j *Job) Wait(c *nuage.Connection, timeout time.Duration, progress func(Job)) error {
+	if j.ID == "" {
+		err := fmt.Errorf("Job Wait: Empty ID, nothing to do")
+		return err
+	}
+
+	start := time.Now()
+
+	for {
+		if err := j.Get(c); err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(*j)
+		}
+
+		if j.Done() {
+			break
+		}
+
+		if timeout > 0 && time.Since(start) >= timeout {
+			err := fmt.Errorf("Job Wait: Timed out after %s waiting for Job with ID: [%s] . Status: [%s] progress: [%.0f%%]", timeout, j.ID, j.Status, 100*j.Progress)
+			return err
+		}
+
+		time.Sleep(JobPollInterval)
+	}
+
+	if j.Status == "FAILED" {
+		err := fmt.Errorf("Job Wait: Job with ID: [%s] command: [%s] failed. Result: %v", j.ID, j.Command, j.Result)
+		return err
+	}
+
+	log.Debugf("Job Wait: Job with ID: [%s] command: [%s] done in %s", j.ID, j.Command, time.Since(start))
+	return nil
+}
+
+// Submit a Job and wait for it to finish. See "Submit" and "Wait"
+func (j *Job) Run(c *nuage.Connection, timeout time.Duration, progress func(Job)) error {
+	if err := j.Submit(c); err != nil {
+		return err
+	}
+	return j.Wait(c, timeout, progress)
+}
+
+////////
+//////// Policy changes (draft mode) -- Domains and Domain templates. Implemented as Jobs
+////////
+
+// How long to wait for a policy change Job to finish
+var PolicyChangeTimeout = 60 * time.Second
+
+// Enter draft mode: ACL changes are staged until applied (or discarded). Caller must initialize the Domain ID (d.ID)
+func (d *Domain) BeginPolicyChanges(c *nuage.Connection) error {
+	return policychange(c, "domain", d.ID, "BEGIN_POLICY_CHANGES")
+}
+
+// Leave draft mode, applying all staged ACL changes at once. Caller must initialize the Domain ID (d.ID)
+func (d *Domain) ApplyPolicyChanges(c *nuage.Connection) error {
+	return policychange(c, "domain", d.ID, "APPLY_POLICY_CHANGES")
+}
+
+// Leave draft mode, dropping all staged ACL changes. Caller must initialize the Domain ID (d.ID)
+func (d *Domain) DiscardPolicyChanges(c *nuage.Connection) error {
+	return policychange(c, "domain", d.ID, "DISCARD_POLICY_CHANGES")
+}
+
+// Enter draft mode. Caller must initialize the Domain template ID (dt.ID)
+func (dt *Domaintemplate) BeginPolicyChanges(c *nuage.Connection) error {
+	return policychange(c, "domaintemplate", dt.ID, "BEGIN_POLICY_CHANGES")
+}
+
+// Leave draft mode, applying all staged ACL changes at once. Caller must initialize the Domain template ID (dt.ID)
+func (dt *Domaintemplate) ApplyPolicyChanges(c *nuage.Connection) error {
+	return policychange(c, "domaintemplate", dt.ID, "APPLY_POLICY_CHANGES")
+}
+
+// Leave draft mode, dropping all staged ACL changes. Caller must initialize the Domain template ID (dt.ID)
+func (dt *Domaintemplate) DiscardPolicyChanges(c *nuage.Connection) error {
+	return policychange(c, "domaintemplate", dt.ID, "DISCARD_POLICY_CHANGES")
+}
+
+// Run a batch of edits (e.g. ACL entries Create / Delete) in draft mode, on a "domain" or "domaintemplate" (by ID):
+// Begin policy changes, run "edits", then apply them all at once. If "edits" fails the changes are discarded and its error returned
+func WithPolicyChanges(c *nuage.Connection, parenttype, parentid string, edits func() error) error {
+	if err := policychange(c, parenttype, parentid, "BEGIN_POLICY_CHANGES"); err != nil {
+		return err
+	}
+
+	if err := edits(); err != nil {
+		log.Debugf("Policy changes: Edits failed on %s with ID: [%s], discarding them. Error: %s ", parenttype, parentid, err)
+		if derr := policychange(c, parenttype, parentid, "DISCARD_POLICY_CHANGES"); derr != nil {
+			return fmt.Errorf("%s (and unable to discard the policy changes: %s)", err, derr)
+		}
+		return err
+	}
+
+	return policychange(c, parenttype, parentid, "APPLY_POLICY_CHANGES")
+}
+
+////////
+//////// Policy changes -- auxiliary functions. Unexported
+////////
+
+func policychange(c *nuage.Connection, parenttype, parentid, command string) error {
+	if parenttype != "domain" && parenttype != "domaintemplate" {
+		err := fmt.Errorf("Policy changes: Invalid parent type: [%s]. Must be either \"domain\" or \"domaintemplate\"", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Policy changes: Empty %s ID, nothing to do", parenttype)
+		return err
+	}
+
+	j := new(Job)
+	j.Command = command
+	j.ParentType = parenttype
+	j.ParentID = parentid
+
+	if err := j.Run(c, PolicyChangeTimeout, nil); err != nil {
+		log.Debugf("Policy changes: Unable to %s on %s with ID: [%s] . Error: %s ", command, parenttype, parentid, err)
+		return err
+	}
+
+	log.Debugf("Policy changes: %s done on %s with ID: [%s]", command, parenttype, parentid)
+	return nil
+}
+
+////////
+//////// Redirection target methods
+////////
+
+// Delete by Redirection target ID (rt.ID)
+func (rt *RedirectionTarget) Delete(c *nuage.Connection) error {
+	if rt.ID == "" {
+		err := fmt.Errorf("Redirection target Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "redirectiontargets", rt.ID)
+
+	if err != nil {
+		log.Debugf("Redirection target Delete: Unable to delete Redirection target with ID: [%s] . Error: %s ", rt.ID, err)
+		return err
+	}
+
+	log.Debugf("Redirection target Delete: Deleted Redirection target with ID: [%s] ", rt.ID)
+	return nil
+}
+
+// Create a new Redirection target. Assumes the method receiver was allocated using "new(RedirectionTarget)"
+// Caller must populate:
+// - Name (rt.Name)
+// - Parent type (rt.ParentType): "domain" or "l2domain"
+// - Parent ID (rt.ParentID)
+// - Optionally: End point type (rt.EndPointType): "L3" (default) or "VIRTUAL_WIRE"
+func (rt *RedirectionTarget) Create(c *nuage.Connection) error {
+	if rt == nil {
+		err := fmt.Errorf("Redirection target Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if rt.Name == "" {
+		err := fmt.Errorf("Redirection target Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if rt.ParentID == "" {
+		err := fmt.Errorf("Redirection target Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if rt.ParentType != "domain" && rt.ParentType != "l2domain" {
+		err := fmt.Errorf("Redirection target Create: Invalid parent type: [%s]. Must be either \"domain\" or \"l2domain\"", rt.ParentType)
+		return err
+	}
+
+	if rt.EndPointType == "" {
+		rt.EndPointType = "L3"
+	}
+
+	if rt.EndPointType != "L3" && rt.EndPointType != "VIRTUAL_WIRE" {
+		err := fmt.Errorf("Redirection target Create: Invalid end point type: [%s]. Must be either \"L3\" or \"VIRTUAL_WIRE\"", rt.EndPointType)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var rta [1]RedirectionTarget
+	// XXX - This copies the supplied fields
+	rta[0] = *rt
+
+	jsonrt, _ := json.MarshalIndent(rta[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, rt.ParentType+"s/"+rt.ParentID+"/redirectiontargets", jsonrt)
+
+	if err != nil {
+		log.Debugf("Redirection target Create: Unable to create Redirection target with name: [%s] . Error: %s ", rt.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &rta)
+
+	if err != nil {
+		log.Debugf("Redirection target Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*rt = rta[0]
+	log.Debugf("Redirection target Create: Created Redirection target with ID: [%s]", rt.ID)
+	return nil
+}
+
+// Get by Redirection target ID (rt.ID)
+func (rt *RedirectionTarget) Get(c *nuage.Connection) error {
+	if rt.ID == "" {
+		err := fmt.Errorf("Redirection target Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "redirectiontargets/"+rt.ID)
+
+	if err != nil {
+		log.Debugf("Redirection target Get: Unable to get Redirection target with ID: [%s] . Error: %s ", rt.ID, err)
+		return err
+	}
+
+	var rta [1]RedirectionTarget
+	err = json.Unmarshal(reply, &rta)
+	if err != nil {
+		log.Debugf("Redirection target Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*rt = rta[0]
+	log.Debugf("Redirection target Get: Found Redirection target with name: [%s] and ID: [%s]", rt.Name, rt.ID)
+	return nil
+}
+
+// Redirection target list for a given parent: "domain" or "l2domain" and parent ID
+func (rts *RedirectionTargetslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parenttype != "domain" && parenttype != "l2domain" {
+		err := fmt.Errorf("Redirection target List: Invalid parent type: [%s]. Must be either \"domain\" or \"l2domain\"", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Redirection target List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/redirectiontargets")
+
+	if err != nil {
+		log.Debugf("Redirection target List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Redirection target List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, rts)
+
+	if err != nil {
+		log.Debugf("Redirection target List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Redirection target List: done")
+	return nil
+}
+
+// VPorts list for a Redirection target.  Caller must initialize the Redirection target ID (rt.ID)
+func (rt *RedirectionTarget) VPortsList(c *nuage.Connection) ([]VPort, error) {
+
+	if rt.ID == "" {
+		err := fmt.Errorf("Redirection target VPorts List: Empty Redirection target ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "redirectiontargets/"+rt.ID+"/vports")
+
+	if err != nil {
+		log.Debugf("Redirection target VPorts List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Redirection target VPorts List: Empty list")
+		return nil, nil
+	}
+
+	var vports []VPort
+
+	err = json.Unmarshal(reply, &vports)
+	if err != nil {
+		log.Debugf("Redirection target VPorts List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Redirection target VPorts List: done")
+	return vports, nil
+
+}
+
+// Redirection target VPorts: Associate VPorts (by ID) with a Redirection target. Caller must initialize the Redirection target ID (rt.ID)
+func (rt *RedirectionTarget) AssignVPorts(c *nuage.Connection, vportids ...string) error {
+	if rt.ID == "" {
+		err := fmt.Errorf("Redirection target Assign VPorts: Empty Redirection target ID, nothing to do")
+		return err
+	}
+
+	members, err := rt.VPortsList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, vp := range members {
+		ids = append(ids, vp.ID)
+	}
+
+	return assignmembers(c, "Redirection target Assign VPorts", "redirectiontargets/"+rt.ID+"/vports", ids, vportids)
+}
+
+// Redirection target VPorts: Disassociate VPorts (by ID) from a Redirection target. Caller must initialize the Redirection target ID (rt.ID)
+func (rt *RedirectionTarget) UnassignVPorts(c *nuage.Connection, vportids ...string) error {
+	if rt.ID == "" {
+		err := fmt.Errorf("Redirection target Unassign VPorts: Empty Redirection target ID, nothing to do")
+		return err
+	}
+
+	members, err := rt.VPortsList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, vp := range members {
+		ids = append(ids, vp.ID)
+	}
+
+	return unassignmembers(c, "Redirection target Unassign VPorts", "redirectiontargets/"+rt.ID+"/vports", ids, vportids)
+}
+
+// Redirection targets list for a VPort.  Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) RedirectionTargetsList(c *nuage.Connection) ([]RedirectionTarget, error) {
+
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Redirection targets List: Empty VPort ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "vports/"+vp.ID+"/redirectiontargets")
+
+	if err != nil {
+		log.Debugf("VPort Redirection targets List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VPort Redirection targets List: Empty list")
+		return nil, nil
+	}
+
+	var rts []RedirectionTarget
+
+	err = json.Unmarshal(reply, &rts)
+	if err != nil {
+		log.Debugf("VPort Redirection targets List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("VPort Redirection targets List: done")
+	return rts, nil
+
+}
+
+////////
+//////// Ingress advanced forwarding template methods
+////////
+
+// Assumes the method receiver was allocated using "new(IngressAdvFwdTemplate)"
+// Caller must populate:
+// - Name (afwd.Name)
+// - Parent ID (afwd.ParentID)
+// - Parent Type (afwd.ParentType): "domain", "domaintemplate", "l2domain" or "l2domaintemplate"
+func (afwd *IngressAdvFwdTemplate) Create(c *nuage.Connection) error {
+	if afwd == nil {
+		err := fmt.Errorf("Ingress advanced forwarding template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if afwd.Name == "" {
+		err := fmt.Errorf("Ingress advanced forwarding template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if afwd.ParentID == "" {
+		err := fmt.Errorf("Ingress advanced forwarding template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validaclparent(afwd.ParentType) {
+		err := fmt.Errorf("Ingress advanced forwarding template Create: Invalid ParentType: [%s]", afwd.ParentType)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var afwda [1]IngressAdvFwdTemplate
+	// XXX - This copies the supplied fields
+	afwda[0] = *afwd
+
+	jsonafwd, _ := json.MarshalIndent(afwda[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, afwd.ParentType+"s/"+afwd.ParentID+"/ingressadvfwdtemplates", jsonafwd)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template Create: Unable to create Ingress advanced forwarding template with name: [%s] . Error: %s ", afwd.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &afwda)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*afwd = afwda[0]
+	log.Debugf("Ingress advanced forwarding template Create: Created Ingress advanced forwarding template with ID: [%s]", afwd.ID)
+	return nil
+}
+
+// Get by Ingress advanced forwarding template ID (afwd.ID)
+func (afwd *IngressAdvFwdTemplate) Get(c *nuage.Connection) error {
+	if afwd.ID == "" {
+		err := fmt.Errorf("Ingress advanced forwarding template Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ingressadvfwdtemplates/"+afwd.ID)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template Get: Unable to get Ingress advanced forwarding template with ID: [%s] . Error: %s ", afwd.ID, err)
+		return err
+	}
+
+	var afwda [1]IngressAdvFwdTemplate
+	err = json.Unmarshal(reply, &afwda)
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*afwd = afwda[0]
+	log.Debugf("Ingress advanced forwarding template Get: Found Ingress advanced forwarding template with Name: [%s] and ID: [%s]", afwd.Name, afwd.ID)
+	return nil
+}
+
+// Ingress advanced forwarding templates of a given parent. Parent type is one of "domain", "domaintemplate", "l2domain" or "l2domaintemplate"
+func (afwds *IngressAdvFwdTemplateslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Ingress advanced forwarding template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validaclparent(parenttype) {
+		err := fmt.Errorf("Ingress advanced forwarding template List: Invalid parent type: [%s]", parenttype)
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/ingressadvfwdtemplates")
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Ingress advanced forwarding template List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, afwds)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Ingress advanced forwarding template List: done")
+	return nil
+}
+
+// Caller must populate the Ingress advanced forwarding template ID (afwd.ID)
+func (afwd *IngressAdvFwdTemplate) Delete(c *nuage.Connection) error {
+	if afwd == nil {
+		err := fmt.Errorf("Ingress advanced forwarding template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if afwd.ID == "" {
+		err := fmt.Errorf("Ingress advanced forwarding template Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "ingressadvfwdtemplates", afwd.ID)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding template Delete: Unable to delete Ingress advanced forwarding template with ID: [%s] . Error: %s ", afwd.ID, err)
+		return err
+	}
+
+	log.Debugf("Ingress advanced forwarding template Delete: Deleted Ingress advanced forwarding template with ID: [%s] ", afwd.ID)
+	return nil
+}
+
+////////
+//////// Ingress advanced forwarding entry methods
+////////
+
+// Assumes the method receiver was allocated using "new(IngressAdvFwdEntry)"
+// Caller must populate:
+// - Parent Ingress advanced forwarding template ID (afwde.ParentID)
+// - Priority (afwde.Priority)
+// - Action (afwde.Action): "FORWARD", "DROP" or "REDIRECT". "REDIRECT" requires the Redirection target ID (afwde.RedirectVPortTagID)
+// Defaults (if empty): Protocol "ANY", EtherType "0x0800" (IPv4), NetworkType / LocationType "ANY"
+func (afwde *IngressAdvFwdEntry) Create(c *nuage.Connection) error {
+	if afwde == nil {
+		err := fmt.Errorf("Ingress advanced forwarding entry Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if afwde.ParentID == "" {
+		err := fmt.Errorf("Ingress advanced forwarding entry Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if afwde.Protocol == "" {
+		afwde.Protocol = "ANY"
+	}
+
+	if afwde.EtherType == "" {
+		afwde.EtherType = "0x0800"
+	}
+
+	if afwde.NetworkType == "" {
+		afwde.NetworkType = "ANY"
+	}
+
+	if afwde.LocationType == "" {
+		afwde.LocationType = "ANY"
+	}
+
+	if err := validadvfwdentry(afwde); err != nil {
+		err = fmt.Errorf("Ingress advanced forwarding entry Create: %s", err)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var afwdea [1]IngressAdvFwdEntry
+	// XXX - This copies the supplied fields
+	afwdea[0] = *afwde
+
+	jsonafwde, _ := json.MarshalIndent(afwdea[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "ingressadvfwdtemplates/"+afwde.ParentID+"/ingressadvfwdentrytemplates", jsonafwde)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry Create: Unable to create Ingress advanced forwarding entry with priority: [%d] . Error: %s ", afwde.Priority, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &afwdea)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*afwde = afwdea[0]
+	log.Debugf("Ingress advanced forwarding entry Create: Created Ingress advanced forwarding entry with ID: [%s]", afwde.ID)
+	return nil
+}
+
+// Get by Ingress advanced forwarding entry ID (afwde.ID)
+func (afwde *IngressAdvFwdEntry) Get(c *nuage.Connection) error {
+	if afwde.ID == "" {
+		err := fmt.Errorf("Ingress advanced forwarding entry Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ingressadvfwdentrytemplates/"+afwde.ID)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry Get: Unable to get Ingress advanced forwarding entry with ID: [%s] . Error: %s ", afwde.ID, err)
+		return err
+	}
+
+	var afwdea [1]IngressAdvFwdEntry
+	err = json.Unmarshal(reply, &afwdea)
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*afwde = afwdea[0]
+	log.Debugf("Ingress advanced forwarding entry Get: Found Ingress advanced forwarding entry with priority: [%d] and ID: [%s]", afwde.Priority, afwde.ID)
+	return nil
+}
+
+// Ingress advanced forwarding entries of a given Ingress advanced forwarding template ID
+func (afwdes *IngressAdvFwdEntryslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Ingress advanced forwarding entry List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ingressadvfwdtemplates/"+parentid+"/ingressadvfwdentrytemplates")
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Ingress advanced forwarding entry List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, afwdes)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Ingress advanced forwarding entry List: done")
+	return nil
+}
+
+// Caller must populate the Ingress advanced forwarding entry ID (afwde.ID)
+func (afwde *IngressAdvFwdEntry) Delete(c *nuage.Connection) error {
+	if afwde == nil {
+		err := fmt.Errorf("Ingress advanced forwarding entry Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if afwde.ID == "" {
+		err := fmt.Errorf("Ingress advanced forwarding entry Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "ingressadvfwdentrytemplates", afwde.ID)
+
+	if err != nil {
+		log.Debugf("Ingress advanced forwarding entry Delete: Unable to delete Ingress advanced forwarding entry with ID: [%s] . Error: %s ", afwde.ID, err)
+		return err
+	}
+
+	log.Debugf("Ingress advanced forwarding entry Delete: Deleted Ingress advanced forwarding entry with ID: [%s] ", afwde.ID)
+	return nil
+}
+
+////////
+//////// Virtual IP methods
+////////
+
+// Delete by Virtual IP ID (vip.ID)
+func (vip *VirtualIP) Delete(c *nuage.Connection) error {
+	if vip.ID == "" {
+		err := fmt.Errorf("Virtual IP Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "virtualips", vip.ID)
+
+	if err != nil {
+		log.Debugf("Virtual IP Delete: Unable to delete Virtual IP with ID: [%s] . Error: %s ", vip.ID, err)
+		return err
+	}
+
+	log.Debugf("Virtual IP Delete: Deleted Virtual IP with ID: [%s] ", vip.ID)
+	return nil
+}
+
+// Create a new Virtual IP on a VPort. Assumes the method receiver was allocated using "new(VirtualIP)"
+// Caller must populate:
+// - Parent VPort ID (vip.ParentID)
+// - Virtual IP address (vip.VirtualIP)
+// - Optionally: MAC address (vip.MAC). Picked by the VSD if empty
+func (vip *VirtualIP) Create(c *nuage.Connection) error {
+	if vip == nil {
+		err := fmt.Errorf("Virtual IP Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if vip.VirtualIP == "" {
+		err := fmt.Errorf("Virtual IP Create: Empty Virtual IP address, nothing to do")
+		return err
+	}
+
+	if vip.ParentID == "" {
+		err := fmt.Errorf("Virtual IP Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	ip := net.ParseIP(vip.VirtualIP).To4()
+	if ip == nil {
+		err := fmt.Errorf("Virtual IP Create: Invalid IP address: [%s]", vip.VirtualIP)
+		return err
+	}
+
+	if vip.MAC != "" {
+		if _, err := net.ParseMAC(vip.MAC); err != nil {
+			err := fmt.Errorf("Virtual IP Create: Invalid MAC address: [%s]", vip.MAC)
+			return err
+		}
+	}
+
+	// For VPorts in a Subnet, the Virtual IP must be in the Subnet range
+	vp := new(VPort)
+	vp.ID = vip.ParentID
+	if err := vp.Get(c); err != nil {
+		return err
+	}
+
+	if vp.ParentType == "subnet" {
+		s := new(Subnet)
+		s.ID = vp.ParentID
+		if err := s.Get(c); err != nil {
+			return err
+		}
+		subnet := net.IPNet{IP: net.ParseIP(s.Address).To4(), Mask: net.IPMask(net.ParseIP(s.Netmask).To4())}
+		if subnet.IP != nil && subnet.Mask != nil && !subnet.Contains(ip) {
+			err := fmt.Errorf("Virtual IP Create: Virtual IP: [%s] not in the range of Subnet: [%s/%s]", vip.VirtualIP, s.Address, s.Netmask)
+			return err
+		}
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var vipa [1]VirtualIP
+	// XXX - This copies the supplied fields
+	vipa[0] = *vip
+
+	jsonvip, _ := json.MarshalIndent(vipa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "vports/"+vip.ParentID+"/virtualips", jsonvip)
+
+	if err != nil {
+		log.Debugf("Virtual IP Create: Unable to create Virtual IP with address: [%s] . Error: %s ", vip.VirtualIP, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &vipa)
+
+	if err != nil {
+		log.Debugf("Virtual IP Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vip = vipa[0]
+	log.Debugf("Virtual IP Create: Created Virtual IP with ID: [%s]", vip.ID)
+	return nil
+}
+
+// Get by Virtual IP ID (vip.ID)
+func (vip *VirtualIP) Get(c *nuage.Connection) error {
+	if vip.ID == "" {
+		err := fmt.Errorf("Virtual IP Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "virtualips/"+vip.ID)
+
+	if err != nil {
+		log.Debugf("Virtual IP Get: Unable to get Virtual IP with ID: [%s] . Error: %s ", vip.ID, err)
+		return err
+	}
+
+	var vipa [1]VirtualIP
+	err = json.Unmarshal(reply, &vipa)
+	if err != nil {
+		log.Debugf("Virtual IP Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vip = vipa[0]
+	log.Debugf("Virtual IP Get: Found Virtual IP with address: [%s] and ID: [%s]", vip.VirtualIP, vip.ID)
+	return nil
+}
+
+// Virtual IP list for a given VPort ID
+func (vips *VirtualIPslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Virtual IP List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vports/"+parentid+"/virtualips")
+
+	if err != nil {
+		log.Debugf("Virtual IP List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Virtual IP List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, vips)
+
+	if err != nil {
+		log.Debugf("Virtual IP List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Virtual IP List: done")
+	return nil
+}
+
+////////
+//////// Host interface methods
+////////
+
+// Delete by Host interface ID (hi.ID)
+func (hi *HostInterface) Delete(c *nuage.Connection) error {
+	if hi.ID == "" {
+		err := fmt.Errorf("Host interface Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "hostinterfaces", hi.ID)
+
+	if err != nil {
+		log.Debugf("Host interface Delete: Unable to delete Host interface with ID: [%s] . Error: %s ", hi.ID, err)
+		return err
+	}
+
+	log.Debugf("Host interface Delete: Deleted Host interface with ID: [%s] ", hi.ID)
+	return nil
+}
+
+// Create a new Host interface on a HOST VPort. Assumes the method receiver was allocated using "new(HostInterface)"
+// Caller must populate:
+// - Name (hi.Name)
+// - Parent VPort ID (hi.ParentID). The VPort must be of type "HOST"
+// - MAC address (hi.MAC)
+// - Optionally: IP address (hi.IPAddress). Allocated from the Subnet if empty
+func (hi *HostInterface) Create(c *nuage.Connection) error {
+	if hi == nil {
+		err := fmt.Errorf("Host interface Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if hi.Name == "" {
+		err := fmt.Errorf("Host interface Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if hi.ParentID == "" {
+		err := fmt.Errorf("Host interface Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if _, err := net.ParseMAC(hi.MAC); err != nil {
+		err := fmt.Errorf("Host interface Create: Invalid MAC address: [%s]", hi.MAC)
+		return err
+	}
+
+	if hi.IPAddress != "" && net.ParseIP(hi.IPAddress).To4() == nil {
+		err := fmt.Errorf("Host interface Create: Invalid IP address: [%s]", hi.IPAddress)
+		return err
+	}
+
+	// The parent VPort must be of the matching type
+	vp := new(VPort)
+	vp.ID = hi.ParentID
+	if err := vp.Get(c); err != nil {
+		return err
+	}
+
+	if vp.Type != "HOST" {
+		err := fmt.Errorf("Host interface Create: VPort with ID: [%s] is of type: [%s]. Must be \"HOST\"", vp.ID, vp.Type)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var hia [1]HostInterface
+	// XXX - This copies the supplied fields
+	hia[0] = *hi
+
+	jsonhi, _ := json.MarshalIndent(hia[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "vports/"+hi.ParentID+"/hostinterfaces", jsonhi)
+
+	if err != nil {
+		log.Debugf("Host interface Create: Unable to create Host interface with name: [%s] . Error: %s ", hi.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &hia)
+
+	if err != nil {
+		log.Debugf("Host interface Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*hi = hia[0]
+	log.Debugf("Host interface Create: Created Host interface with ID: [%s]", hi.ID)
+	return nil
+}
+
+// Get by Host interface ID (hi.ID)
+func (hi *HostInterface) Get(c *nuage.Connection) error {
+	if hi.ID == "" {
+		err := fmt.Errorf("Host interface Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "hostinterfaces/"+hi.ID)
+
+	if err != nil {
+		log.Debugf("Host interface Get: Unable to get Host interface with ID: [%s] . Error: %s ", hi.ID, err)
+		return err
+	}
+
+	var hia [1]HostInterface
+	err = json.Unmarshal(reply, &hia)
+	if err != nil {
+		log.Debugf("Host interface Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*hi = hia[0]
+	log.Debugf("Host interface Get: Found Host interface with name: [%s] and ID: [%s]", hi.Name, hi.ID)
+	return nil
+}
+
+// Host interface list for a given VPort ID
+func (his *HostInterfaceslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Host interface List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vports/"+parentid+"/hostinterfaces")
+
+	if err != nil {
+		log.Debugf("Host interface List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Host interface List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, his)
+
+	if err != nil {
+		log.Debugf("Host interface List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Host interface List: done")
+	return nil
+}
+
+// Host interfaces list for a Domain.  Caller must initialize the Domain ID (d.ID)
+func (d *Domain) HostInterfacesList(c *nuage.Connection) ([]HostInterface, error) {
+
+	if d.ID == "" {
+		err := fmt.Errorf("Domain Host interfaces List: Empty Domain ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "domains/"+d.ID+"/hostinterfaces")
+
+	if err != nil {
+		log.Debugf("Domain Host interfaces List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Domain Host interfaces List: Empty list")
+		return nil, nil
+	}
+
+	var his []HostInterface
+
+	err = json.Unmarshal(reply, &his)
+	if err != nil {
+		log.Debugf("Domain Host interfaces List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Domain Host interfaces List: done")
+	return his, nil
+
+}
+
+// Host interfaces list for a Subnet.  Caller must initialize the Subnet ID (s.ID)
+func (s *Subnet) HostInterfacesList(c *nuage.Connection) ([]HostInterface, error) {
+
+	if s.ID == "" {
+		err := fmt.Errorf("Subnet Host interfaces List: Empty Subnet ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "subnets/"+s.ID+"/hostinterfaces")
+
+	if err != nil {
+		log.Debugf("Subnet Host interfaces List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Subnet Host interfaces List: Empty list")
+		return nil, nil
+	}
+
+	var his []HostInterface
+
+	err = json.Unmarshal(reply, &his)
+	if err != nil {
+		log.Debugf("Subnet Host interfaces List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Subnet Host interfaces List: done")
+	return his, nil
+
+}
+
+////////
+//////// Bridge interface methods
+////////
+
+// Delete by Bridge interface ID (bi.ID)
+func (bi *BridgeInterface) Delete(c *nuage.Connection) error {
+	if bi.ID == "" {
+		err := fmt.Errorf("Bridge interface Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "bridgeinterfaces", bi.ID)
+
+	if err != nil {
+		log.Debugf("Bridge interface Delete: Unable to delete Bridge interface with ID: [%s] . Error: %s ", bi.ID, err)
+		return err
+	}
+
+	log.Debugf("Bridge interface Delete: Deleted Bridge interface with ID: [%s] ", bi.ID)
+	return nil
+}
+
+// Create a new Bridge interface on a BRIDGE VPort. Assumes the method receiver was allocated using "new(BridgeInterface)"
+// Caller must populate:
+// - Name (bi.Name)
+// - Parent VPort ID (bi.ParentID). The VPort must be of type "BRIDGE"
+func (bi *BridgeInterface) Create(c *nuage.Connection) error {
+	if bi == nil {
+		err := fmt.Errorf("Bridge interface Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if bi.Name == "" {
+		err := fmt.Errorf("Bridge interface Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if bi.ParentID == "" {
+		err := fmt.Errorf("Bridge interface Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// The parent VPort must be of the matching type
+	vp := new(VPort)
+	vp.ID = bi.ParentID
+	if err := vp.Get(c); err != nil {
+		return err
+	}
+
+	if vp.Type != "BRIDGE" {
+		err := fmt.Errorf("Bridge interface Create: VPort with ID: [%s] is of type: [%s]. Must be \"BRIDGE\"", vp.ID, vp.Type)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var bia [1]BridgeInterface
+	// XXX - This copies the supplied fields
+	bia[0] = *bi
+
+	jsonbi, _ := json.MarshalIndent(bia[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "vports/"+bi.ParentID+"/bridgeinterfaces", jsonbi)
+
+	if err != nil {
+		log.Debugf("Bridge interface Create: Unable to create Bridge interface with name: [%s] . Error: %s ", bi.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &bia)
+
+	if err != nil {
+		log.Debugf("Bridge interface Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*bi = bia[0]
+	log.Debugf("Bridge interface Create: Created Bridge interface with ID: [%s]", bi.ID)
+	return nil
+}
+
+// Get by Bridge interface ID (bi.ID)
+func (bi *BridgeInterface) Get(c *nuage.Connection) error {
+	if bi.ID == "" {
+		err := fmt.Errorf("Bridge interface Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "bridgeinterfaces/"+bi.ID)
+
+	if err != nil {
+		log.Debugf("Bridge interface Get: Unable to get Bridge interface with ID: [%s] . Error: %s ", bi.ID, err)
+		return err
+	}
+
+	var bia [1]BridgeInterface
+	err = json.Unmarshal(reply, &bia)
+	if err != nil {
+		log.Debugf("Bridge interface Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*bi = bia[0]
+	log.Debugf("Bridge interface Get: Found Bridge interface with name: [%s] and ID: [%s]", bi.Name, bi.ID)
+	return nil
+}
+
+// Bridge interface list for a given VPort ID
+func (bis *BridgeInterfaceslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Bridge interface List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vports/"+parentid+"/bridgeinterfaces")
+
+	if err != nil {
+		log.Debugf("Bridge interface List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Bridge interface List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, bis)
+
+	if err != nil {
+		log.Debugf("Bridge interface List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Bridge interface List: done")
+	return nil
+}
+
+// Bridge interfaces list for a Domain.  Caller must initialize the Domain ID (d.ID)
+func (d *Domain) BridgeInterfacesList(c *nuage.Connection) ([]BridgeInterface, error) {
+
+	if d.ID == "" {
+		err := fmt.Errorf("Domain Bridge interfaces List: Empty Domain ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "domains/"+d.ID+"/bridgeinterfaces")
+
+	if err != nil {
+		log.Debugf("Domain Bridge interfaces List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Domain Bridge interfaces List: Empty list")
+		return nil, nil
+	}
+
+	var bis []BridgeInterface
+
+	err = json.Unmarshal(reply, &bis)
+	if err != nil {
+		log.Debugf("Domain Bridge interfaces List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Domain Bridge interfaces List: done")
+	return bis, nil
+
+}
+
+// Bridge interfaces list for a Subnet.  Caller must initialize the Subnet ID (s.ID)
+func (s *Subnet) BridgeInterfacesList(c *nuage.Connection) ([]BridgeInterface, error) {
+
+	if s.ID == "" {
+		err := fmt.Errorf("Subnet Bridge interfaces List: Empty Subnet ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "subnets/"+s.ID+"/bridgeinterfaces")
+
+	if err != nil {
+		log.Debugf("Subnet Bridge interfaces List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Subnet Bridge interfaces List: Empty list")
+		return nil, nil
+	}
+
+	var bis []BridgeInterface
+
+	err = json.Unmarshal(reply, &bis)
+	if err != nil {
+		log.Debugf("Subnet Bridge interfaces List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Subnet Bridge interfaces List: done")
+	return bis, nil
+
+}
+
+// Bridge interfaces list for an L2 Domain.  Caller must initialize the L2 Domain ID (l2d.ID)
+func (l2d *L2Domain) BridgeInterfacesList(c *nuage.Connection) ([]BridgeInterface, error) {
+
+	if l2d.ID == "" {
+		err := fmt.Errorf("L2 Domain Bridge interfaces List: Empty L2 Domain ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "l2domains/"+l2d.ID+"/bridgeinterfaces")
+
+	if err != nil {
+		log.Debugf("L2 Domain Bridge interfaces List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("L2 Domain Bridge interfaces List: Empty list")
+		return nil, nil
+	}
+
+	var bis []BridgeInterface
+
+	err = json.Unmarshal(reply, &bis)
+	if err != nil {
+		log.Debugf("L2 Domain Bridge interfaces List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("L2 Domain Bridge interfaces List: done")
+	return bis, nil
+
+}
+
+////////
+//////// VSP methods -- read only
+////////
+
+// Get by VSP ID (vsp.ID)
+func (vsp *VSP) Get(c *nuage.Connection) error {
+	if vsp.ID == "" {
+		err := fmt.Errorf("VSP Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vsps/"+vsp.ID)
+
+	if err != nil {
+		log.Debugf("VSP Get: Unable to get VSP with ID: [%s] . Error: %s ", vsp.ID, err)
+		return err
+	}
+
+	var vspa [1]VSP
+	err = json.Unmarshal(reply, &vspa)
+	if err != nil {
+		log.Debugf("VSP Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vsp = vspa[0]
+	log.Debugf("VSP Get: Found VSP with name: [%s] and ID: [%s]", vsp.Name, vsp.ID)
+	return nil
+}
+
+// VSP list
+func (vsps *VSPslice) List(c *nuage.Connection) error {
+	reply, err := nuage.GetEntity(c, "vsps")
+
+	if err != nil {
+		log.Debugf("VSP List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VSP List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, vsps)
+
+	if err != nil {
+		log.Debugf("VSP List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("VSP List: done")
+	return nil
+}
+
+////////
+//////// VSC methods -- read only
+////////
+
+// Get by VSC ID (vsc.ID)
+func (vsc *VSC) Get(c *nuage.Connection) error {
+	if vsc.ID == "" {
+		err := fmt.Errorf("VSC Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vscs/"+vsc.ID)
+
+	if err != nil {
+		log.Debugf("VSC Get: Unable to get VSC with ID: [%s] . Error: %s ", vsc.ID, err)
+		return err
+	}
+
+	var vsca [1]VSC
+	err = json.Unmarshal(reply, &vsca)
+	if err != nil {
+		log.Debugf("VSC Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vsc = vsca[0]
+	log.Debugf("VSC Get: Found VSC with name: [%s] and ID: [%s]", vsc.Name, vsc.ID)
+	return nil
+}
+
+// VSC list for a given VSP ID
+func (vscs *VSCslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("VSC List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vsps/"+parentid+"/vscs")
+
+	if err != nil {
+		log.Debugf("VSC List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VSC List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, vscs)
+
+	if err != nil {
+		log.Debugf("VSC List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("VSC List: done")
+	return nil
+}
+
+////////
+//////// VRS methods -- read only
+////////
+
+// Get by VRS ID (vrs.ID)
+func (vrs *VRS) Get(c *nuage.Connection) error {
+	if vrs.ID == "" {
+		err := fmt.Errorf("VRS Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vrss/"+vrs.ID)
+
+	if err != nil {
+		log.Debugf("VRS Get: Unable to get VRS with ID: [%s] . Error: %s ", vrs.ID, err)
+		return err
+	}
+
+	var vrsa [1]VRS
+	err = json.Unmarshal(reply, &vrsa)
+	if err != nil {
+		log.Debugf("VRS Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vrs = vrsa[0]
+	log.Debugf("VRS Get: Found VRS with name: [%s] and ID: [%s]", vrs.Name, vrs.ID)
+	return nil
+}
+
+// VRS list for a given VSC ID. If empty, the VRSs of all the VSPs
+func (vrss *VRSslice) List(c *nuage.Connection, vscid string) error {
+	if vscid != "" {
+		reply, err := nuage.GetEntity(c, "vscs/"+vscid+"/vrss")
+		if err != nil {
+			log.Debugf("VRS List: Unable to obtain list: %s ", err)
+			return err
+		}
+
+		if len(reply) == 0 {
+			log.Debugf("VRS List: Empty list")
+			return nil
+		}
+
+		err = json.Unmarshal(reply, vrss)
+		if err != nil {
+			log.Debugf("VRS List: Unable to decode JSON payload: %s ", err)
+			return err
+		}
+		log.Debug("VRS List: done")
+		return nil
+	}
+
+	// No global VRS list in the API -- walk the VSPs
+	var vsps VSPslice
+	err := vsps.List(c)
+	if err != nil {
+		return err
+	}
+
+	for _, vsp := range vsps {
+		reply, err := nuage.GetEntity(c, "vsps/"+vsp.ID+"/vrss")
+		if err != nil {
+			log.Debugf("VRS List: Unable to obtain list for VSP with ID: [%s] . Error: %s ", vsp.ID, err)
+			return err
+		}
+
+		if len(reply) == 0 {
+			continue
+		}
+
+		var vspvrss VRSslice
+		err = json.Unmarshal(reply, &vspvrss)
+		if err != nil {
+			log.Debugf("VRS List: Unable to decode JSON payload: %s ", err)
+			return err
+		}
+		*vrss = append(*vrss, vspvrss...)
+	}
+
+	log.Debug("VRS List: done")
+	return nil
+}
+
+// Virtual Machines connected to a VRS. Caller must initialize the VRS ID (vrs.ID)
+func (vrs *VRS) VMsList(c *nuage.Connection) ([]VirtualMachine, error) {
+
+	if vrs.ID == "" {
+		err := fmt.Errorf("VRS VMs List: Empty VRS ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "vrss/"+vrs.ID+"/vms")
+
+	if err != nil {
+		log.Debugf("VRS VMs List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VRS VMs List: Empty list")
+		return nil, nil
+	}
+
+	var vms []VirtualMachine
+
+	err = json.Unmarshal(reply, &vms)
+	if err != nil {
+		log.Debugf("VRS VMs List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("VRS VMs List: done")
+	return vms, nil
+
+}
+
+////////
+//////// Gateway methods
+////////
+
+// Get by Gateway ID (gw.ID)
+func (gw *Gateway) Get(c *nuage.Connection) error {
+	if gw.ID == "" {
+		err := fmt.Errorf("Gateway Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "gateways/"+gw.ID)
+
+	if err != nil {
+		log.Debugf("Gateway Get: Unable to get Gateway with ID: [%s] . Error: %s ", gw.ID, err)
+		return err
+	}
+
+	var gwa [1]Gateway
+	err = json.Unmarshal(reply, &gwa)
+	if err != nil {
+		log.Debugf("Gateway Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*gw = gwa[0]
+	log.Debugf("Gateway Get: Found Gateway with name: [%s] and ID: [%s]", gw.Name, gw.ID)
+	return nil
+}
+
+// Gateway list for a given Enterprise ID (or global list if empty)
+func (gws *Gatewayslice) List(c *nuage.Connection, parentid string) error {
+	var reply []byte
+	var err error
+
+	if parentid == "" { // get global list
+		reply, err = nuage.GetEntity(c, "gateways")
+	} else {
+		// get the list for a given Enterprise ID
+		reply, err = nuage.GetEntity(c, "enterprises/"+parentid+"/gateways")
+	}
+
+	if err != nil {
+		log.Debugf("Gateway List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Gateway List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, gws)
+
+	if err != nil {
+		log.Debugf("Gateway List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Gateway List: done")
+	return nil
+}
+
+////////
+//////// Gateway port methods
+////////
+
+// Get by Port ID (p.ID)
+func (p *Port) Get(c *nuage.Connection) error {
+	if p.ID == "" {
+		err := fmt.Errorf("Port Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ports/"+p.ID)
+
+	if err != nil {
+		log.Debugf("Port Get: Unable to get Port with ID: [%s] . Error: %s ", p.ID, err)
+		return err
+	}
+
+	var pa [1]Port
+	err = json.Unmarshal(reply, &pa)
+	if err != nil {
+		log.Debugf("Port Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*p = pa[0]
+	log.Debugf("Port Get: Found Port with name: [%s] and ID: [%s]", p.Name, p.ID)
+	return nil
+}
+
+// Port list for a given Gateway ID
+func (ps *Portslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Port List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "gateways/"+parentid+"/ports")
+
+	if err != nil {
+		log.Debugf("Port List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Port List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, ps)
+
+	if err != nil {
+		log.Debugf("Port List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Port List: done")
+	return nil
+}
+
+////////
+//////// VLAN methods
+////////
+
+// Delete by VLAN ID (vlan.ID)
+func (vlan *VLAN) Delete(c *nuage.Connection) error {
+	if vlan.ID == "" {
+		err := fmt.Errorf("VLAN Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "vlans", vlan.ID)
+
+	if err != nil {
+		log.Debugf("VLAN Delete: Unable to delete VLAN with ID: [%s] . Error: %s ", vlan.ID, err)
+		return err
+	}
+
+	log.Debugf("VLAN Delete: Deleted VLAN with ID: [%s] ", vlan.ID)
+	return nil
+}
+
+// Create a new VLAN on a gateway port. Assumes the method receiver was allocated using "new(VLAN)"
+// Caller must populate:
+// - Parent Port ID (vlan.ParentID)
+// - VLAN number (vlan.Value)
+func (vlan *VLAN) Create(c *nuage.Connection) error {
+	if vlan == nil {
+		err := fmt.Errorf("VLAN Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if vlan.ParentID == "" {
+		err := fmt.Errorf("VLAN Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if vlan.Value < 0 || vlan.Value > 4094 {
+		err := fmt.Errorf("VLAN Create: Invalid VLAN number: [%d]. Must be between 0 and 4094", vlan.Value)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var vlana [1]VLAN
+	// XXX - This copies the supplied fields
+	vlana[0] = *vlan
+
+	jsonvlan, _ := json.MarshalIndent(vlana[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "ports/"+vlan.ParentID+"/vlans", jsonvlan)
+
+	if err != nil {
+		log.Debugf("VLAN Create: Unable to create VLAN: [%d] on Port with ID: [%s] . Error: %s ", vlan.Value, vlan.ParentID, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &vlana)
+
+	if err != nil {
+		log.Debugf("VLAN Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vlan = vlana[0]
+	log.Debugf("VLAN Create: Created VLAN with ID: [%s]", vlan.ID)
+	return nil
+}
+
+// Get by VLAN ID (vlan.ID)
+func (vlan *VLAN) Get(c *nuage.Connection) error {
+	if vlan.ID == "" {
+		err := fmt.Errorf("VLAN Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "vlans/"+vlan.ID)
+
+	if err != nil {
+		log.Debugf("VLAN Get: Unable to get VLAN with ID: [%s] . Error: %s ", vlan.ID, err)
+		return err
+	}
+
+	var vlana [1]VLAN
+	err = json.Unmarshal(reply, &vlana)
+	if err != nil {
+		log.Debugf("VLAN Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*vlan = vlana[0]
+	log.Debugf("VLAN Get: Found VLAN with ID: [%s]", vlan.ID)
+	return nil
+}
+
+// VLAN list for a given Port ID
+func (vlans *VLANslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("VLAN List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ports/"+parentid+"/vlans")
+
+	if err != nil {
+		log.Debugf("VLAN List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VLAN List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, vlans)
+
+	if err != nil {
+		log.Debugf("VLAN List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("VLAN List: done")
+	return nil
+}
+
+// Create a BRIDGE or HOST VPort bound to this VLAN, in a Subnet or L2 Domain. Caller must initialize the VLAN ID (vlan.ID). The VPort must have:
+// - A Name (vp.Name)
+// - A Type (vp.Type): "BRIDGE" or "HOST"
+// - A Parent type (vp.ParentType): "subnet" or "l2domain" (BRIDGE VPorts only)
+// - A Parent ID (vp.ParentID)
+func (vlan *VLAN) CreateVPort(c *nuage.Connection, vp VPort) (VPort, error) {
+	var vpa [1]VPort
+
+	// In the worst case we return what we received
+	vpa[0] = vp
+
+	if vlan.ID == "" {
+		err := fmt.Errorf("VLAN Create VPort: Empty VLAN ID, nothing to do")
+		return vpa[0], err
+	}
+
+	if vp.Name == "" || vp.ParentID == "" {
+		err := fmt.Errorf("VLAN Create VPort: Invalid VPort initialization, nothing to do")
+		return vpa[0], err
+	}
+
+	if vp.Type != "BRIDGE" && vp.Type != "HOST" {
+		err := fmt.Errorf("VLAN Create VPort: Invalid VPort type: [%s]. Must be either \"BRIDGE\" or \"HOST\"", vp.Type)
+		return vpa[0], err
+	}
+
+	if vp.ParentType != "subnet" && !(vp.ParentType == "l2domain" && vp.Type == "BRIDGE") {
+		err := fmt.Errorf("VLAN Create VPort: Invalid parent type: [%s] for a %s VPort", vp.ParentType, vp.Type)
+		return vpa[0], err
+	}
+
+	// The VLAN must exist and be free
+	err := vlan.Get(c)
+	if err != nil {
+		return vpa[0], err
+	}
+
+	if vlan.VPortID != "" {
+		err := fmt.Errorf("VLAN Create VPort: VLAN [%d] with ID: [%s] already bound to VPort with ID: [%s]", vlan.Value, vlan.ID, vlan.VPortID)
+		return vpa[0], err
+	}
+
+	vp.VLANID = vlan.ID
+	if vp.AddressSpoofing == "" {
+		vp.AddressSpoofing = "INHERITED"
+	}
+
+	jsonvport, _ := json.MarshalIndent(vp, "", "\t")
+	reply, err := nuage.CreateEntity(c, vp.ParentType+"s/"+vp.ParentID+"/vports", jsonvport)
+
+	if err != nil {
+		log.Debugf("VLAN Create VPort: Error: %s ", err)
+		return vpa[0], err
+	}
+
+	err = json.Unmarshal(reply, &vpa)
+	if err != nil {
+		log.Debugf("VLAN Create VPort:  Unable to decode JSON payload: %s ", err)
+		return vpa[0], err
+	}
+	log.Debugf("VLAN Create VPort: Created %s VPort with ID: [%s] on VLAN with ID: [%s]", vpa[0].Type, vpa[0].ID, vlan.ID)
+	return vpa[0], nil
+
+}
+
+////////
+//////// DHCP option methods
+////////
+
+// Delete by DHCP option ID (o.ID)
+func (o *DHCPOption) Delete(c *nuage.Connection) error {
+	if o.ID == "" {
+		err := fmt.Errorf("DHCP option Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "dhcpoptions", o.ID)
+
+	if err != nil {
+		log.Debugf("DHCP option Delete: Unable to delete DHCP option with ID: [%s] . Error: %s ", o.ID, err)
+		return err
+	}
+
+	log.Debugf("DHCP option Delete: Deleted DHCP option with ID: [%s] ", o.ID)
+	return nil
+}
+
+// Create a new DHCP option. Assumes the method receiver was allocated using "new(DHCPOption)"
+// Caller must populate:
+// - Parent type (o.ParentType): "domain", "zone", "subnet", "vport" or "l2domain"
+// - Parent ID (o.ParentID)
+// - Type and Value, using "Encode"
+func (o *DHCPOption) Create(c *nuage.Connection) error {
+	if o == nil {
+		err := fmt.Errorf("DHCP option Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if !validdhcpparent(o.ParentType) {
+		err := fmt.Errorf("DHCP option Create: Invalid parent type: [%s]. Must be one of: domain, zone, subnet, vport, l2domain", o.ParentType)
+		return err
+	}
+
+	if o.ParentID == "" {
+		err := fmt.Errorf("DHCP option Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if o.Type == "" || o.Value == "" {
+		err := fmt.Errorf("DHCP option Create: Empty Type or Value. Use \"Encode\" to set them")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var oa [1]DHCPOption
+	// XXX - This copies the supplied fields
+	oa[0] = *o
+
+	jsono, _ := json.MarshalIndent(oa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, o.ParentType+"s/"+o.ParentID+"/dhcpoptions", jsono)
+
+	if err != nil {
+		log.Debugf("DHCP option Create: Unable to create DHCP option of type: [%s] . Error: %s ", o.Type, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &oa)
+
+	if err != nil {
+		log.Debugf("DHCP option Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*o = oa[0]
+	log.Debugf("DHCP option Create: Created DHCP option of type: [%s] with ID: [%s]", o.Type, o.ID)
+	return nil
+}
+
+// Get by DHCP option ID (o.ID)
+func (o *DHCPOption) Get(c *nuage.Connection) error {
+	if o.ID == "" {
+		err := fmt.Errorf("DHCP option Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "dhcpoptions/"+o.ID)
+
+	if err != nil {
+		log.Debugf("DHCP option Get: Unable to get DHCP option with ID: [%s] . Error: %s ", o.ID, err)
+		return err
+	}
+
+	var oa [1]DHCPOption
+	err = json.Unmarshal(reply, &oa)
+	if err != nil {
+		log.Debugf("DHCP option Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*o = oa[0]
+	log.Debugf("DHCP option Get: Found DHCP option of type: [%s] with ID: [%s]", o.Type, o.ID)
+	return nil
+}
+
+// DHCP option list for a given parent: "domain", "zone", "subnet", "vport" or "l2domain" and parent ID
+func (dos *DHCPOptionslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if !validdhcpparent(parenttype) {
+		err := fmt.Errorf("DHCP option List: Invalid parent type: [%s]. Must be one of: domain, zone, subnet, vport, l2domain", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("DHCP option List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/dhcpoptions")
+
+	if err != nil {
+		log.Debugf("DHCP option List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("DHCP option List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, dos)
+
+	if err != nil {
+		log.Debugf("DHCP option List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("DHCP option List: done")
+	return nil
+}
+
+// Effective DHCP options for a VPort: Its own, plus those inherited from its Subnet, Zone and Domain (or its L2 Domain).
+// The most specific one wins. The ParentType / ParentID of each option show where it is defined.  Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) EffectiveDHCPOptions(c *nuage.Connection) ([]DHCPOption, error) {
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Effective DHCP options: Empty VPort ID, nothing to do")
+		return nil, err
+	}
+
+	chain, err := vp.parentchain(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var effective []DHCPOption
+	seen := make(map[int]bool)
+
+	for _, p := range chain {
+		var opts DHCPOptionslice
+		if err := opts.List(c, p.ptype, p.pid); err != nil {
+			return nil, err
+		}
+		for _, o := range opts {
+			code, err := strconv.ParseUint(o.Type, 16, 8)
+			if err != nil {
+				log.Debugf("VPort Effective DHCP options: Ignoring DHCP option with invalid type: [%s]", o.Type)
+				continue
+			}
+			if seen[int(code)] {
+				continue
+			}
+			seen[int(code)] = true
+			// Make sure the source is always recorded
+			o.ParentType = p.ptype
+			o.ParentID = p.pid
+			effective = append(effective, o)
+		}
+	}
+
+	log.Debugf("VPort Effective DHCP options: Found [%d] DHCP options for VPort with ID: [%s]", len(effective), vp.ID)
+	return effective, nil
+}
+
+// Encode a DHCP option from its code and human readable value(s). Sets Type, Length and Value. Value formats:
+// - netmask, broadcast (1, 28): an IPv4 address
+// - router, dns, ntp, netbiosns (3, 6, 42, 44): one or more IPv4 addresses
+// - hostname, domainname, tftpserver, bootfile (12, 15, 66, 67): a string
+// - mtu (26): a 16 bit unsigned integer. leasetime (51): a 32 bit unsigned integer, in seconds
+// - routes (121): one or more "<Prefix>/<Length>,<Next hop>" classless static routes (RFC 3442)
+// - any other code: a single hex encoded value, e.g. "0a0b0c"
+func (o *DHCPOption) Encode(code int, values ...string) error {
+	if code <= 0 || code >= 255 {
+		return fmt.Errorf("DHCP option Encode: Invalid DHCP option code: [%d]", code)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("DHCP option Encode: No value for DHCP option code: [%d]", code)
+	}
+
+	var data []byte
+
+	switch code {
+	case 1, 28:
+		if len(values) != 1 {
+			return fmt.Errorf("DHCP option Encode: DHCP option code: [%d] takes exactly one IPv4 address", code)
+		}
+		fallthrough
+	case 3, 6, 42, 44:
+		for _, v := range values {
+			ip := net.ParseIP(v).To4()
+			if ip == nil {
+				return fmt.Errorf("DHCP option Encode: Invalid IPv4 address: [%s]", v)
+			}
+			data = append(data, ip...)
+		}
+	case 12, 15, 66, 67:
+		data = []byte(strings.Join(values, " "))
+	case 26, 51:
+		bits := 16
+		if code == 51 {
+			bits = 32
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("DHCP option Encode: DHCP option code: [%d] takes exactly one integer value", code)
+		}
+		n, err := strconv.ParseUint(values[0], 10, bits)
+		if err != nil {
+			return fmt.Errorf("DHCP option Encode: Invalid %d bit unsigned integer: [%s]", bits, values[0])
+		}
+		for i := bits/8 - 1; i >= 0; i-- {
+			data = append(data, byte(n>>(8*uint(i))))
+		}
+	case 121:
+		for _, v := range values {
+			route, err := encodeclasslessroute(v)
+			if err != nil {
+				return fmt.Errorf("DHCP option Encode: %s", err)
+			}
+			data = append(data, route...)
+		}
+	default:
+		if len(values) != 1 {
+			return fmt.Errorf("DHCP option Encode: DHCP option code: [%d] takes a single hex encoded value", code)
+		}
+		raw, err := hex.DecodeString(values[0])
+		if err != nil {
+			return fmt.Errorf("DHCP option Encode: Invalid hex value: [%s]", values[0])
+		}
+		data = raw
+	}
+
+	if len(data) == 0 || len(data) > 255 {
+		return fmt.Errorf("DHCP option Encode: Invalid value length: [%d] bytes for DHCP option code: [%d]", len(data), code)
+	}
+
+	o.Type = fmt.Sprintf("%02x", code)
+	o.Length = fmt.Sprintf("%02x", len(data))
+	o.Value = hex.EncodeToString(data)
+	return nil
+}
+
+// Decode a DHCP option into its code and human readable value(s) -- the reverse of "Encode"
+func (o *DHCPOption) Decode() (int, []string, error) {
+	code, err := strconv.ParseUint(o.Type, 16, 8)
+	if err != nil {
+		return 0, nil, fmt.Errorf("DHCP option Decode: Invalid type: [%s]", o.Type)
+	}
+
+	data, err := hex.DecodeString(o.Value)
+	if err != nil {
+		return int(code), nil, fmt.Errorf("DHCP option Decode: Invalid hex value: [%s]", o.Value)
+	}
+
+	var values []string
+
+	switch code {
+	case 1, 3, 6, 28, 42, 44:
+		if len(data) == 0 || len(data)%4 != 0 {
+			return int(code), nil, fmt.Errorf("DHCP option Decode: Invalid length: [%d] for a list of IPv4 addresses", len(data))
+		}
+		for i := 0; i < len(data); i += 4 {
+			values = append(values, net.IP(data[i:i+4]).String())
+		}
+	case 12, 15, 66, 67:
+		values = append(values, string(data))
+	case 26, 51:
+		if (code == 26 && len(data) != 2) || (code == 51 && len(data) != 4) {
+			return int(code), nil, fmt.Errorf("DHCP option Decode: Invalid length: [%d] for DHCP option code: [%d]", len(data), code)
+		}
+		var n uint64
+		for _, b := range data {
+			n = n<<8 | uint64(b)
+		}
+		values = append(values, strconv.FormatUint(n, 10))
+	case 121:
+		for len(data) > 0 {
+			route, rest, err := decodeclasslessroute(data)
+			if err != nil {
+				return int(code), nil, fmt.Errorf("DHCP option Decode: %s", err)
+			}
+			values = append(values, route)
+			data = rest
+		}
+	default:
+		values = append(values, o.Value)
+	}
+
+	return int(code), values, nil
+}
+
+////////
+//////// DHCP options -- auxiliary functions. Unexported
+////////
+
+// A parent entity in the VPort hierarchy
+type vportparent struct{ ptype, pid string }
+
+// The chain of parents of a VPort, the VPort itself included, most specific first:
+// VPort, Subnet, Zone, Domain -- or VPort, L2 Domain
+func (vp *VPort) parentchain(c *nuage.Connection) ([]vportparent, error) {
+	if err := vp.Get(c); err != nil {
+		return nil, err
+	}
+
+	chain := []vportparent{{"vport", vp.ID}}
+
+	switch vp.ParentType {
+	case "subnet":
+		s := new(Subnet)
+		s.ID = vp.ParentID
+		if err := s.Get(c); err != nil {
+			return nil, err
+		}
+		z := new(Zone)
+		z.ID = s.ParentID
+		if err := z.Get(c); err != nil {
+			return nil, err
+		}
+		chain = append(chain, vportparent{"subnet", s.ID}, vportparent{"zone", z.ID}, vportparent{"domain", z.ParentID})
+	case "l2domain":
+		chain = append(chain, vportparent{"l2domain", vp.ParentID})
+	default:
+		err := fmt.Errorf("Unknown parent type: [%s] for VPort with ID: [%s]", vp.ParentType, vp.ID)
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+func validdhcpparent(parenttype string) bool {
+	switch parenttype {
+	case "domain", "zone", "subnet", "vport", "l2domain":
+		return true
+	}
+	return false
+}
+
+// RFC 3442 encoding of "<Prefix>/<Length>,<Next hop>": Prefix length, significant octets of the prefix, next hop
+func encodeclasslessroute(route string) ([]byte, error) {
+	parts := strings.Split(route, ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid classless static route: [%s]. Format: <Prefix>/<Length>,<Next hop>", route)
+	}
+
+	ip, ipnet, err := net.ParseCIDR(parts[0])
+	if err != nil || ip.To4() == nil {
+		return nil, fmt.Errorf("Invalid destination prefix: [%s]", parts[0])
+	}
+
+	nexthop := net.ParseIP(parts[1]).To4()
+	if nexthop == nil {
+		return nil, fmt.Errorf("Invalid next hop address: [%s]", parts[1])
+	}
+
+	ones, _ := ipnet.Mask.Size()
+	data := []byte{byte(ones)}
+	data = append(data, ipnet.IP.To4()[:(ones+7)/8]...)
+	return append(data, nexthop...), nil
+}
+
+// Reverse of "encodeclasslessroute". Returns the decoded route and the remaining data
+func decodeclasslessroute(data []byte) (string, []byte, error) {
+	ones := int(data[0])
+	if ones > 32 {
+		return "", nil, fmt.Errorf("Invalid classless static route prefix length: [%d]", ones)
+	}
+
+	octets := (ones + 7) / 8
+	if len(data) < 1+octets+4 {
+		return "", nil, fmt.Errorf("Truncated classless static route")
+	}
+
+	prefix := make(net.IP, 4)
+	copy(prefix, data[1:1+octets])
+	nexthop := net.IP(data[1+octets : 1+octets+4])
+
+	return fmt.Sprintf("%s/%d,%s", prefix.String(), ones, nexthop.String()), data[1+octets+4:], nil
+}
+
+////////
+//////// User methods
+////////
+
+// Delete by User ID (u.ID)
+func (u *User) Delete(c *nuage.Connection) error {
+	if u.ID == "" {
+		err := fmt.Errorf("User Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "users", u.ID)
+
+	if err != nil {
+		log.Debugf("User Delete: Unable to delete User with ID: [%s] . Error: %s ", u.ID, err)
+		return err
+	}
+
+	log.Debugf("User Delete: Deleted User with ID: [%s] ", u.ID)
+	return nil
+}
+
+// Create a new User. Assumes the method receiver was allocated using "new(User)"
+// Caller must populate:
+// - User name (u.UserName)
+// - Parent Enterprise ID (u.ParentID)
+// - First name, Last name, Email (u.FirstName, u.LastName, u.Email)
+// - Password, using "SetPassword"
+func (u *User) Create(c *nuage.Connection) error {
+	if u == nil {
+		err := fmt.Errorf("User Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if u.UserName == "" {
+		err := fmt.Errorf("User Create: Empty UserName, nothing to do")
+		return err
+	}
+
+	if u.ParentID == "" {
+		err := fmt.Errorf("User Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validpasswordhash(u.Password) {
+		err := fmt.Errorf("User Create: Password not set, or not a SHA1 hash. Use \"SetPassword\" to set it")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var ua [1]User
+	// XXX - This copies the supplied fields
+	ua[0] = *u
+
+	jsonu, _ := json.MarshalIndent(ua[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+u.ParentID+"/users", jsonu)
+
+	if err != nil {
+		log.Debugf("User Create: Unable to create User with name: [%s] . Error: %s ", u.UserName, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &ua)
+
+	if err != nil {
+		log.Debugf("User Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*u = ua[0]
+	log.Debugf("User Create: Created User with ID: [%s]", u.ID)
+	return nil
+}
+
+// Get by User ID (u.ID)
+func (u *User) Get(c *nuage.Connection) error {
+	if u.ID == "" {
+		err := fmt.Errorf("User Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "users/"+u.ID)
+
+	if err != nil {
+		log.Debugf("User Get: Unable to get User with ID: [%s] . Error: %s ", u.ID, err)
+		return err
+	}
+
+	var ua [1]User
+	err = json.Unmarshal(reply, &ua)
+	if err != nil {
+		log.Debugf("User Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*u = ua[0]
+	log.Debugf("User Get: Found User with name: [%s] and ID: [%s]", u.UserName, u.ID)
+	return nil
+}
+
+// User list for a given Enterprise ID
+func (us *Userslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("User List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/users")
+
+	if err != nil {
+		log.Debugf("User List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("User List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, us)
+
+	if err != nil {
+		log.Debugf("User List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("User List: done")
+	return nil
+}
+
+// Set the User password from clear text. The VSD expects the (hex encoded) SHA1 hash of the password, never the password itself
+func (u *User) SetPassword(password string) {
+	hash := sha1.Sum([]byte(password))
+	u.Password = hex.EncodeToString(hash[:])
+}
+
+// Change the password of an existing User. Caller must initialize the User ID (u.ID)
+func (u *User) ChangePassword(c *nuage.Connection, password string) error {
+	if u.ID == "" {
+		err := fmt.Errorf("User Change Password: Empty ID, nothing to do")
+		return err
+	}
+
+	if password == "" {
+		err := fmt.Errorf("User Change Password: Empty password, nothing to do")
+		return err
+	}
+
+	u.SetPassword(password)
+	jsonpass, _ := json.Marshal(map[string]string{"password": u.Password})
+	_, err := nuage.UpdateEntity(c, "users/"+u.ID, jsonpass)
+
+	if err != nil {
+		log.Debugf("User Change Password: Unable to change password for User with ID: [%s] . Error: %s ", u.ID, err)
+		return err
+	}
+
+	log.Debugf("User Change Password: Changed password for User with ID: [%s]", u.ID)
+	return nil
+}
+
+// Groups list for a User. Caller must initialize the User ID (u.ID)
+func (u *User) GroupsList(c *nuage.Connection) ([]Group, error) {
+
+	if u.ID == "" {
+		err := fmt.Errorf("User Groups List: Empty User ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "users/"+u.ID+"/groups")
+
+	if err != nil {
+		log.Debugf("User Groups List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("User Groups List: Empty list")
+		return nil, nil
+	}
+
+	var groups []Group
+
+	err = json.Unmarshal(reply, &groups)
+	if err != nil {
+		log.Debugf("User Groups List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("User Groups List: done")
+	return groups, nil
+
+}
+
+////////
+//////// Group methods
+////////
+
+// Delete by Group ID (g.ID)
+func (g *Group) Delete(c *nuage.Connection) error {
+	if g.ID == "" {
+		err := fmt.Errorf("Group Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "groups", g.ID)
+
+	if err != nil {
+		log.Debugf("Group Delete: Unable to delete Group with ID: [%s] . Error: %s ", g.ID, err)
+		return err
+	}
+
+	log.Debugf("Group Delete: Deleted Group with ID: [%s] ", g.ID)
+	return nil
+}
+
+// Create a new Group. Assumes the method receiver was allocated using "new(Group)"
+// Caller must populate:
+// - Name (g.Name)
+// - Parent Enterprise ID (g.ParentID)
+func (g *Group) Create(c *nuage.Connection) error {
+	if g == nil {
+		err := fmt.Errorf("Group Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if g.Name == "" {
+		err := fmt.Errorf("Group Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if g.ParentID == "" {
+		err := fmt.Errorf("Group Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var ga [1]Group
+	// XXX - This copies the supplied fields
+	ga[0] = *g
+
+	jsong, _ := json.MarshalIndent(ga[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+g.ParentID+"/groups", jsong)
+
+	if err != nil {
+		log.Debugf("Group Create: Unable to create Group with name: [%s] . Error: %s ", g.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &ga)
+
+	if err != nil {
+		log.Debugf("Group Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*g = ga[0]
+	log.Debugf("Group Create: Created Group with ID: [%s]", g.ID)
+	return nil
+}
+
+// Get by Group ID (g.ID)
+func (g *Group) Get(c *nuage.Connection) error {
+	if g.ID == "" {
+		err := fmt.Errorf("Group Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "groups/"+g.ID)
+
+	if err != nil {
+		log.Debugf("Group Get: Unable to get Group with ID: [%s] . Error: %s ", g.ID, err)
+		return err
+	}
+
+	var ga [1]Group
+	err = json.Unmarshal(reply, &ga)
+	if err != nil {
+		log.Debugf("Group Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*g = ga[0]
+	log.Debugf("Group Get: Found Group with name: [%s] and ID: [%s]", g.Name, g.ID)
+	return nil
+}
+
+// Group list for a given Enterprise ID
+func (gs *Groupslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Group List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/groups")
+
+	if err != nil {
+		log.Debugf("Group List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Group List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, gs)
+
+	if err != nil {
+		log.Debugf("Group List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Group List: done")
+	return nil
+}
+
+// Group members: Users list. Caller must initialize the Group ID (g.ID)
+func (g *Group) UsersList(c *nuage.Connection) ([]User, error) {
+
+	if g.ID == "" {
+		err := fmt.Errorf("Group Users List: Empty Group ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "groups/"+g.ID+"/users")
+
+	if err != nil {
+		log.Debugf("Group Users List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Group Users List: Empty list")
+		return nil, nil
+	}
+
+	var users []User
+
+	err = json.Unmarshal(reply, &users)
+	if err != nil {
+		log.Debugf("Group Users List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Group Users List: done")
+	return users, nil
+
+}
+
+// Group members: Add Users (by ID) to a Group. Caller must initialize the Group ID (g.ID)
+func (g *Group) AddUsers(c *nuage.Connection, userids ...string) error {
+	if g.ID == "" {
+		err := fmt.Errorf("Group Add Users: Empty Group ID, nothing to do")
+		return err
+	}
+
+	members, err := g.UsersList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, u := range members {
+		ids = append(ids, u.ID)
+	}
+
+	return assignmembers(c, "Group Add Users", "groups/"+g.ID+"/users", ids, userids)
+}
+
+// Group members: Remove Users (by ID) from a Group. Caller must initialize the Group ID (g.ID)
+func (g *Group) RemoveUsers(c *nuage.Connection, userids ...string) error {
+	if g.ID == "" {
+		err := fmt.Errorf("Group Remove Users: Empty Group ID, nothing to do")
+		return err
+	}
+
+	members, err := g.UsersList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, u := range members {
+		ids = append(ids, u.ID)
+	}
+
+	return unassignmembers(c, "Group Remove Users", "groups/"+g.ID+"/users", ids, userids)
+}
+
+////////
+//////// Users -- auxiliary functions. Unexported
+////////
+
+// A (hex encoded) SHA1 hash: 40 hex digits
+func validpasswordhash(password string) bool {
+	if len(password) != 2*sha1.Size {
+		return false
+	}
+	_, err := hex.DecodeString(password)
+	return err == nil
+}
+
+////////
+//////// Enterprise network (network macro) methods
+////////
+
+// Delete by Enterprise network ID (en.ID)
+func (en *EnterpriseNetwork) Delete(c *nuage.Connection) error {
+	if en.ID == "" {
+		err := fmt.Errorf("Enterprise network Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "enterprisenetworks", en.ID)
+
+	if err != nil {
+		log.Debugf("Enterprise network Delete: Unable to delete Enterprise network with ID: [%s] . Error: %s ", en.ID, err)
+		return err
+	}
+
+	log.Debugf("Enterprise network Delete: Deleted Enterprise network with ID: [%s] ", en.ID)
+	return nil
+}
+
+// Create a new Enterprise network (network macro). Assumes the method receiver was allocated using "new(EnterpriseNetwork)"
+// Caller must populate:
+// - Name (en.Name)
+// - Parent Enterprise ID (en.ParentID)
+// - Address / Netmask of the network (en.Address, en.Netmask)
+func (en *EnterpriseNetwork) Create(c *nuage.Connection) error {
+	if en == nil {
+		err := fmt.Errorf("Enterprise network Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if en.Name == "" {
+		err := fmt.Errorf("Enterprise network Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if en.ParentID == "" {
+		err := fmt.Errorf("Enterprise network Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if en.IPType == "" {
+		en.IPType = "IPV4"
+	}
+
+	if err := validmacro(en.Address, en.Netmask); err != nil {
+		return fmt.Errorf("Enterprise network Create: %s", err)
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var ena [1]EnterpriseNetwork
+	// XXX - This copies the supplied fields
+	ena[0] = *en
+
+	jsonen, _ := json.MarshalIndent(ena[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+en.ParentID+"/enterprisenetworks", jsonen)
+
+	if err != nil {
+		log.Debugf("Enterprise network Create: Unable to create Enterprise network with name: [%s] . Error: %s ", en.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &ena)
+
+	if err != nil {
+		log.Debugf("Enterprise network Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*en = ena[0]
+	log.Debugf("Enterprise network Create: Created Enterprise network with ID: [%s]", en.ID)
+	return nil
+}
+
+// Get by Enterprise network ID (en.ID)
+func (en *EnterpriseNetwork) Get(c *nuage.Connection) error {
+	if en.ID == "" {
+		err := fmt.Errorf("Enterprise network Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprisenetworks/"+en.ID)
+
+	if err != nil {
+		log.Debugf("Enterprise network Get: Unable to get Enterprise network with ID: [%s] . Error: %s ", en.ID, err)
+		return err
+	}
+
+	var ena [1]EnterpriseNetwork
+	err = json.Unmarshal(reply, &ena)
+	if err != nil {
+		log.Debugf("Enterprise network Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*en = ena[0]
+	log.Debugf("Enterprise network Get: Found Enterprise network with name: [%s] and ID: [%s]", en.Name, en.ID)
+	return nil
+}
+
+// Enterprise network list for a given Enterprise ID
+func (ens *EnterpriseNetworkslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Enterprise network List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/enterprisenetworks")
+
+	if err != nil {
+		log.Debugf("Enterprise network List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Enterprise network List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, ens)
+
+	if err != nil {
+		log.Debugf("Enterprise network List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Enterprise network List: done")
+	return nil
+}
+
+// Network macro groups containing a given Enterprise network. Caller must initialize the Enterprise network ID (en.ID)
+// There is no direct API for this: Walk the Network macro groups of the parent Enterprise and check their members
+func (en *EnterpriseNetwork) NetworkMacroGroupsList(c *nuage.Connection) ([]NetworkMacroGroup, error) {
+	if en.ID == "" {
+		err := fmt.Errorf("Enterprise network Network macro groups List: Empty Enterprise network ID, nothing to do")
+		return nil, err
+	}
+
+	if en.ParentID == "" {
+		if err := en.Get(c); err != nil {
+			return nil, err
+		}
+	}
+
+	var nmgs NetworkMacroGroupslice
+	if err := nmgs.List(c, en.ParentID); err != nil {
+		return nil, err
+	}
+
+	var groups []NetworkMacroGroup
+	for _, nmg := range nmgs {
+		members, err := nmg.EnterpriseNetworksList(c)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.ID == en.ID {
+				groups = append(groups, nmg)
+				break
+			}
+		}
+	}
+
+	log.Debugf("Enterprise network Network macro groups List: Enterprise network with ID: [%s] is a member of [%d] Network macro groups", en.ID, len(groups))
+	return groups, nil
+}
+
+////////
+//////// Network macro group methods
+////////
+
+// Delete by Network macro group ID (nmg.ID)
+func (nmg *NetworkMacroGroup) Delete(c *nuage.Connection) error {
+	if nmg.ID == "" {
+		err := fmt.Errorf("Network macro group Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "networkmacrogroups", nmg.ID)
+
+	if err != nil {
+		log.Debugf("Network macro group Delete: Unable to delete Network macro group with ID: [%s] . Error: %s ", nmg.ID, err)
+		return err
+	}
+
+	log.Debugf("Network macro group Delete: Deleted Network macro group with ID: [%s] ", nmg.ID)
+	return nil
+}
+
+// Create a new Network macro group. Assumes the method receiver was allocated using "new(NetworkMacroGroup)"
+// Caller must populate:
+// - Name (nmg.Name)
+// - Parent Enterprise ID (nmg.ParentID)
+func (nmg *NetworkMacroGroup) Create(c *nuage.Connection) error {
+	if nmg == nil {
+		err := fmt.Errorf("Network macro group Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if nmg.Name == "" {
+		err := fmt.Errorf("Network macro group Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if nmg.ParentID == "" {
+		err := fmt.Errorf("Network macro group Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var nmga [1]NetworkMacroGroup
+	// XXX - This copies the supplied fields
+	nmga[0] = *nmg
+
+	jsonnmg, _ := json.MarshalIndent(nmga[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "enterprises/"+nmg.ParentID+"/networkmacrogroups", jsonnmg)
+
+	if err != nil {
+		log.Debugf("Network macro group Create: Unable to create Network macro group with name: [%s] . Error: %s ", nmg.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &nmga)
+
+	if err != nil {
+		log.Debugf("Network macro group Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*nmg = nmga[0]
+	log.Debugf("Network macro group Create: Created Network macro group with ID: [%s]", nmg.ID)
+	return nil
+}
+
+// Get by Network macro group ID (nmg.ID)
+func (nmg *NetworkMacroGroup) Get(c *nuage.Connection) error {
+	if nmg.ID == "" {
+		err := fmt.Errorf("Network macro group Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "networkmacrogroups/"+nmg.ID)
+
+	if err != nil {
+		log.Debugf("Network macro group Get: Unable to get Network macro group with ID: [%s] . Error: %s ", nmg.ID, err)
+		return err
+	}
+
+	var nmga [1]NetworkMacroGroup
+	err = json.Unmarshal(reply, &nmga)
+	if err != nil {
+		log.Debugf("Network macro group Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*nmg = nmga[0]
+	log.Debugf("Network macro group Get: Found Network macro group with name: [%s] and ID: [%s]", nmg.Name, nmg.ID)
+	return nil
+}
+
+// Network macro group list for a given Enterprise ID
+func (nmgs *NetworkMacroGroupslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Network macro group List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "enterprises/"+parentid+"/networkmacrogroups")
+
+	if err != nil {
+		log.Debugf("Network macro group List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Network macro group List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, nmgs)
+
+	if err != nil {
+		log.Debugf("Network macro group List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Network macro group List: done")
+	return nil
+}
+
+// Network macro group members: Enterprise networks list. Caller must initialize the Network macro group ID (nmg.ID)
+func (nmg *NetworkMacroGroup) EnterpriseNetworksList(c *nuage.Connection) ([]EnterpriseNetwork, error) {
+
+	if nmg.ID == "" {
+		err := fmt.Errorf("Network macro group Enterprise networks List: Empty Network macro group ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "networkmacrogroups/"+nmg.ID+"/enterprisenetworks")
+
+	if err != nil {
+		log.Debugf("Network macro group Enterprise networks List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Network macro group Enterprise networks List: Empty list")
+		return nil, nil
+	}
+
+	var ens []EnterpriseNetwork
+
+	err = json.Unmarshal(reply, &ens)
+	if err != nil {
+		log.Debugf("Network macro group Enterprise networks List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Network macro group Enterprise networks List: done")
+	return ens, nil
+
+}
+
+// Network macro group members: Assign Enterprise networks (by ID) to a Network macro group. Caller must initialize the Network macro group ID (nmg.ID)
+func (nmg *NetworkMacroGroup) AssignEnterpriseNetworks(c *nuage.Connection, enids ...string) error {
+	if nmg.ID == "" {
+		err := fmt.Errorf("Network macro group Assign Enterprise networks: Empty Network macro group ID, nothing to do")
+		return err
+	}
+
+	members, err := nmg.EnterpriseNetworksList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, en := range members {
+		ids = append(ids, en.ID)
+	}
+
+	return assignmembers(c, "Network macro group Assign Enterprise networks", "networkmacrogroups/"+nmg.ID+"/enterprisenetworks", ids, enids)
+}
+
+// Network macro group members: Unassign Enterprise networks (by ID) from a Network macro group. Caller must initialize the Network macro group ID (nmg.ID)
+func (nmg *NetworkMacroGroup) UnassignEnterpriseNetworks(c *nuage.Connection, enids ...string) error {
+	if nmg.ID == "" {
+		err := fmt.Errorf("Network macro group Unassign Enterprise networks: Empty Network macro group ID, nothing to do")
+		return err
+	}
+
+	members, err := nmg.EnterpriseNetworksList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, en := range members {
+		ids = append(ids, en.ID)
+	}
+
+	return unassignmembers(c, "Network macro group Unassign Enterprise networks", "networkmacrogroups/"+nmg.ID+"/enterprisenetworks", ids, enids)
+}
+
+////////
+//////// Enterprise networks -- auxiliary functions. Unexported
+////////
+
+// Validate a network macro: A valid IPv4 address and contiguous netmask, with no host bits set
+func validmacro(address, netmask string) error {
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return fmt.Errorf("Invalid address: [%s]", address)
+	}
+
+	nm := net.ParseIP(netmask).To4()
+	if nm == nil {
+		return fmt.Errorf("Invalid netmask: [%s]", netmask)
+	}
+
+	mask := net.IPMask(nm)
+	if ones, bits := mask.Size(); ones == 0 && bits == 0 {
+		return fmt.Errorf("Invalid netmask: [%s]. Not a contiguous mask", netmask)
+	}
+
+	if !ip.Mask(mask).Equal(ip) {
+		return fmt.Errorf("Invalid network: [%s/%s]. Host bits set", address, netmask)
+	}
+
+	return nil
+}
+
+////////
+//////// Static route methods
+////////
+
+// Delete by Static route ID (sr.ID)
+func (sr *StaticRoute) Delete(c *nuage.Connection) error {
+	if sr.ID == "" {
+		err := fmt.Errorf("Static route Delete: Empty ID, nothing to do")
+		return err
+	}
+
+	_, err := nuage.DeleteEntity(c, "staticroutes", sr.ID)
+
+	if err != nil {
+		log.Debugf("Static route Delete: Unable to delete Static route with ID: [%s] . Error: %s ", sr.ID, err)
+		return err
+	}
+
+	log.Debugf("Static route Delete: Deleted Static route with ID: [%s] ", sr.ID)
+	return nil
+}
+
+// Create a new Static route. Assumes the method receiver was allocated using "new(StaticRoute)"
+// Caller must populate:
+// - Parent type (sr.ParentType): "domain" or "l2domain"
+// - Parent ID (sr.ParentID)
+// - Address / Netmask of the destination prefix (sr.Address, sr.Netmask)
+// - Next hop IP address (sr.NextHopIp)
+// - Optionally: Type (sr.Type): "OVERLAY" (default) or "EXIT_DOMAIN"
+func (sr *StaticRoute) Create(c *nuage.Connection) error {
+	if sr == nil {
+		err := fmt.Errorf("Static route Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if sr.ParentType != "domain" && sr.ParentType != "l2domain" {
+		err := fmt.Errorf("Static route Create: Invalid parent type: [%s]. Must be either \"domain\" or \"l2domain\"", sr.ParentType)
+		return err
+	}
+
+	if sr.ParentID == "" {
+		err := fmt.Errorf("Static route Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if sr.Type == "" {
+		sr.Type = "OVERLAY"
+	}
+
+	if sr.IPType == "" {
+		sr.IPType = "IPV4"
+	}
+
+	if err := validstaticroute(sr.Address, sr.Netmask, sr.NextHopIp, sr.Type); err != nil {
+		return fmt.Errorf("Static route Create: %s", err)
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var sra [1]StaticRoute
+	sra[0] = *sr
+
+	jsonsr, _ := json.MarshalIndent(sra[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, sr.ParentType+"s/"+sr.ParentID+"/staticroutes", jsonsr)
+
+	if err != nil {
+		log.Debugf("Static route Create: Unable to create Static route: [%s/%s] via [%s] . Error: %s ", sr.Address, sr.Netmask, sr.NextHopIp, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &sra)
+
+	if err != nil {
+		log.Debugf("Static route Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*sr = sra[0]
+	log.Debugf("Static route Create: Created Static route: [%s/%s] via [%s] with ID: [%s]", sr.Address, sr.Netmask, sr.NextHopIp, sr.ID)
+	return nil
+}
+
+// Get by Static route ID (sr.ID)
+func (sr *StaticRoute) Get(c *nuage.Connection) error {
+	if sr.ID == "" {
+		err := fmt.Errorf("Static route Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "staticroutes/"+sr.ID)
+
+	if err != nil {
+		log.Debugf("Static route Get: Unable to get Static route with ID: [%s] . Error: %s ", sr.ID, err)
+		return err
+	}
+
+	var sra [1]StaticRoute
+	err = json.Unmarshal(reply, &sra)
+	if err != nil {
+		log.Debugf("Static route Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*sr = sra[0]
+	log.Debugf("Static route Get: Found Static route: [%s/%s] via [%s] with ID: [%s]", sr.Address, sr.Netmask, sr.NextHopIp, sr.ID)
+	return nil
+}
+
+// Static route list for a given parent: "domain" or "l2domain" and parent ID
+func (srs *StaticRouteslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parenttype != "domain" && parenttype != "l2domain" {
+		err := fmt.Errorf("Static route List: Invalid parent type: [%s]. Must be either \"domain\" or \"l2domain\"", parenttype)
+		return err
+	}
+
+	if parentid == "" {
+		err := fmt.Errorf("Static route List: Empty parent ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/staticroutes")
+
+	if err != nil {
+		log.Debugf("Static route List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Static route List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, srs)
+
+	if err != nil {
+		log.Debugf("Static route List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Static route List: done")
+	return nil
+}
+
+////////
+//////// Static routes -- auxiliary functions. Unexported
+////////
+
+// Validate a Static route: The destination must be a valid IPv4 prefix (address with no host bits set), the next hop a valid IPv4 unicast address outside that prefix
+func validstaticroute(address, netmask, nexthop, routetype string) error {
+	if routetype != "OVERLAY" && routetype != "EXIT_DOMAIN" {
+		return fmt.Errorf("Invalid route type: [%s]. Must be either \"OVERLAY\" or \"EXIT_DOMAIN\"", routetype)
+	}
+
+	ip := parseipv4(address)
+	if ip == nil {
+		return fmt.Errorf("Invalid destination address: [%s]", address)
+	}
+
+	nm := parseipv4(netmask)
+	if nm == nil {
+		return fmt.Errorf("Invalid netmask: [%s]", netmask)
+	}
+
+	mask := net.IPMask(nm)
+	ones, bits := mask.Size()
+	if ones == 0 && bits == 0 {
+		return fmt.Errorf("Invalid netmask: [%s]. Not a contiguous mask", netmask)
+	}
+
+	prefix := net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	if !prefix.IP.Equal(ip) {
+		return fmt.Errorf("Invalid destination prefix: [%s/%s]. Host bits set, did you mean [%s] ?", address, netmask, prefix.String())
+	}
+
+	nh := parseipv4(nexthop)
+	if nh == nil {
+		return fmt.Errorf("Invalid next hop address: [%s]", nexthop)
+	}
+
+	if nh.IsUnspecified() || nh.IsLoopback() || nh.IsMulticast() || nh.Equal(net.IPv4bcast) {
+		return fmt.Errorf("Invalid next hop address: [%s]. Not a unicast address", nexthop)
+	}
+
+	// A default route (0.0.0.0/0) contains any next hop
+	if ones > 0 && prefix.Contains(nh) {
+		return fmt.Errorf("Invalid next hop address: [%s]. Inside the destination prefix [%s]", nexthop, prefix.String())
+	}
+
+	return nil
+}
+
+// An IPv4 address in dotted decimal, or nil. Unlike "net.ParseIP(...).To4()", rejects IPv4-mapped IPv6 addresses (e.g. "::ffff:10.0.0.1")
+func parseipv4(s string) net.IP {
+	if strings.Contains(s, ":") {
+		return nil
+	}
+	return net.ParseIP(s).To4()
+}
+
+////////
+//////// Shared network resource methods
+////////
+
+// Caller must populate the Shared network resource ID (snr.ID)
+func (snr *SharedNetworkResource) Delete(c *nuage.Connection) error {
+	if snr == nil {
+		err := fmt.Errorf("Shared network resource Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if snr.ID == "" {
+		err := fmt.Errorf("Shared network resource Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "sharednetworkresources", snr.ID)
+
+	if err != nil {
+		log.Debugf("Shared network resource Delete: Unable to delete Shared network resource with ID: [%s] . Error: %s ", snr.ID, err)
+		return err
+	}
+
+	log.Debugf("Shared network resource Delete: Deleted Shared network resource with ID: [%s] ", snr.ID)
+	return nil
+}
+
+// Assumes the method receiver was allocated using "new(SharedNetworkResource)"
+// Caller must populate:
+// - Name (snr.Name)
+// - Type (snr.Type) -- e.g. "FLOATING" for a FIP pool
+// - Address (snr.Address), Netmask (snr.Netmask) and Gateway (snr.Gateway)
+func (snr *SharedNetworkResource) Create(c *nuage.Connection) error {
+	if snr == nil {
+		err := fmt.Errorf("Shared network resource Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if snr.Name == "" {
+		err := fmt.Errorf("Shared network resource Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if snr.Type == "" {
+		err := fmt.Errorf("Shared network resource Create: Empty Type, nothing to do")
+		return err
+	}
+
+	if snr.Address == "" || snr.Netmask == "" || snr.Gateway == "" {
+		err := fmt.Errorf("Shared network resource Create: Need Address, Netmask and Gateway. Nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var snra [1]SharedNetworkResource
+	// XXX - This copies the supplied fields
+	snra[0] = *snr
+
+	jsonsnr, _ := json.MarshalIndent(snra[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "sharednetworkresources", jsonsnr)
+
+	if err != nil {
+		log.Debugf("Shared network resource Create: Unable to create Shared network resource with name: [%s] . Error: %s ", snr.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &snra)
+
+	if err != nil {
+		log.Debugf("Shared network resource Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*snr = snra[0]
+	log.Debugf("Shared network resource Create: Created Shared network resource with ID: [%s]", snr.ID)
+	return nil
+}
+
+// Get by Shared network resource ID (snr.ID)
+func (snr *SharedNetworkResource) Get(c *nuage.Connection) error {
+	if snr.ID == "" {
+		err := fmt.Errorf("Shared network resource Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "sharednetworkresources/"+snr.ID)
+
+	if err != nil {
+		log.Debugf("Shared network resource Get: Unable to get Shared network resource with ID: [%s] . Error: %s ", snr.ID, err)
+		return err
+	}
+
+	var snra [1]SharedNetworkResource
+	err = json.Unmarshal(reply, &snra)
+	if err != nil {
+		log.Debugf("Shared network resource Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*snr = snra[0]
+	log.Debugf("Shared network resource Get: Found Shared network resource with Name: [%s] and ID: [%s]", snr.Name, snr.ID)
+	return nil
+}
+
+// Global list (all Shared network resources). If "restype" is not empty, only those of that type -- e.g. "FLOATING" for FIP pools
+func (snrs *SharedNetworkResourceslice) List(c *nuage.Connection, restype string) error {
+
+	reply, err := nuage.GetEntity(c, "sharednetworkresources")
+
+	if err != nil {
+		log.Debugf("Shared network resource List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Shared network resource List: Empty list")
+		return nil
+	}
+
+	var all []SharedNetworkResource
+	err = json.Unmarshal(reply, &all)
+
+	if err != nil {
+		log.Debugf("Shared network resource List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	for _, snr := range all {
+		if restype == "" || snr.Type == restype {
+			*snrs = append(*snrs, snr)
+		}
+	}
+
+	log.Debug("Shared network resource List: done")
+	return nil
+}
+
+////////
+//////// Floating IP methods
+////////
+
+// Allocate a Floating IP to a Domain, from a FIP pool. Assumes the method receiver was allocated using "new(FloatingIP)"
+// Caller must populate:
+// - Parent Domain ID (fip.ParentID)
+// - FIP pool ID (fip.AssociatedSharedNetworkResourceID)
+// - Optionally: Address (fip.Address). Picked by the VSD from the FIP pool if empty
+func (fip *FloatingIP) Allocate(c *nuage.Connection) error {
+	if fip == nil {
+		err := fmt.Errorf("Floating IP Allocate: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if fip.ParentID == "" {
+		err := fmt.Errorf("Floating IP Allocate: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if fip.AssociatedSharedNetworkResourceID == "" {
+		err := fmt.Errorf("Floating IP Allocate: Empty FIP pool ID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var fipa [1]FloatingIP
+	fipa[0] = *fip
+
+	jsonfip, _ := json.MarshalIndent(fipa[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "domains/"+fip.ParentID+"/floatingips", jsonfip)
+
+	if err != nil {
+		log.Debugf("Floating IP Allocate: Unable to allocate Floating IP from FIP pool: [%s] . Error: %s ", fip.AssociatedSharedNetworkResourceID, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &fipa)
+
+	if err != nil {
+		log.Debugf("Floating IP Allocate: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*fip = fipa[0]
+	log.Debugf("Floating IP Allocate: Allocated Floating IP: [%s] with ID: [%s]", fip.Address, fip.ID)
+	return nil
+}
+
+// Release a Floating IP back to its FIP pool. Caller must populate the Floating IP ID (fip.ID). Fails if the Floating IP is still associated with a VPort
+func (fip *FloatingIP) Release(c *nuage.Connection) error {
+	if fip == nil {
+		err := fmt.Errorf("Floating IP Release: Empty method receiver, nothing to do")
+		return err
+	}
+
+	err := fip.Get(c)
+	if err != nil {
+		return err
+	}
+
+	if fip.Assigned {
+		err := fmt.Errorf("Floating IP Release: Floating IP: [%s] still associated with a %s. Disassociate it first", fip.Address, fip.AssignedToObjectType)
+		return err
+	}
+
+	_, err = nuage.DeleteEntity(c, "floatingips", fip.ID)
+
+	if err != nil {
+		log.Debugf("Floating IP Release: Unable to release Floating IP with ID: [%s] . Error: %s ", fip.ID, err)
+		return err
+	}
+
+	log.Debugf("Floating IP Release: Released Floating IP: [%s] with ID: [%s] ", fip.Address, fip.ID)
+	return nil
+}
+
+// Get by Floating IP ID (fip.ID)
+func (fip *FloatingIP) Get(c *nuage.Connection) error {
+	if fip.ID == "" {
+		err := fmt.Errorf("Floating IP Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "floatingips/"+fip.ID)
+
+	if err != nil {
+		log.Debugf("Floating IP Get: Unable to get Floating IP with ID: [%s] . Error: %s ", fip.ID, err)
+		return err
+	}
+
+	var fipa [1]FloatingIP
+	err = json.Unmarshal(reply, &fipa)
+	if err != nil {
+		log.Debugf("Floating IP Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*fip = fipa[0]
+	log.Debugf("Floating IP Get: Found Floating IP with Address: [%s] and ID: [%s]", fip.Address, fip.ID)
+	return nil
+}
+
+// Floating IP list for a given Domain ID (or global list if empty)
+func (fips *FloatingIPslice) List(c *nuage.Connection, parentid string) error {
+	var reply []byte
+	var err error
+
+	if parentid == "" { // get global list
+		reply, err = nuage.GetEntity(c, "floatingips")
+	} else {
+		// get the list for a given Domain ID
+		reply, err = nuage.GetEntity(c, "domains/"+parentid+"/floatingips")
+	}
+
+	if err != nil {
+		log.Debugf("Floating IP List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Floating IP List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, fips)
+
+	if err != nil {
+		log.Debugf("Floating IP List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Floating IP List: done")
+	return nil
+}
+
+// Associate a Floating IP (by ID) with a VPort. Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) AssociateFloatingIP(c *nuage.Connection, fipid string) error {
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Associate Floating IP: Empty VPort ID, nothing to do")
+		return err
+	}
+
+	if fipid == "" {
+		err := fmt.Errorf("VPort Associate Floating IP: Empty Floating IP ID, nothing to do")
+		return err
+	}
+
+	jsonfip, _ := json.Marshal(map[string]string{"associatedFloatingIPID": fipid})
+	_, err := nuage.UpdateEntity(c, "vports/"+vp.ID, jsonfip)
+
+	if err != nil {
+		log.Debugf("VPort Associate Floating IP: Unable to associate Floating IP with ID: [%s] to VPort with ID: [%s] . Error: %s ", fipid, vp.ID, err)
+		return err
+	}
+
+	vp.AssociatedFloatingIPID = fipid
+	log.Debugf("VPort Associate Floating IP: Associated Floating IP with ID: [%s] to VPort with ID: [%s]", fipid, vp.ID)
+	return nil
+}
+
+// Disassociate the Floating IP from a VPort. Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) DisassociateFloatingIP(c *nuage.Connection) error {
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Disassociate Floating IP: Empty VPort ID, nothing to do")
+		return err
+	}
+
+	// "null" clears the association. Can't use the VPort itself since "associatedFloatingIPID" is omitted when empty
+	jsonfip := []byte(`{"associatedFloatingIPID": null}`)
+	_, err := nuage.UpdateEntity(c, "vports/"+vp.ID, jsonfip)
+
+	if err != nil {
+		log.Debugf("VPort Disassociate Floating IP: Unable to disassociate Floating IP from VPort with ID: [%s] . Error: %s ", vp.ID, err)
+		return err
+	}
+
+	vp.AssociatedFloatingIPID = ""
+	log.Debugf("VPort Disassociate Floating IP: Disassociated Floating IP from VPort with ID: [%s]", vp.ID)
+	return nil
+}
+
+////////
+//////// Policy group methods
+////////
+
+// Caller must populate the Policy group ID (pg.ID)
+func (pg *PolicyGroup) Delete(c *nuage.Connection) error {
+	if pg == nil {
+		err := fmt.Errorf("Policy group Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if pg.ID == "" {
+		err := fmt.Errorf("Policy group Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "policygroups", pg.ID)
+
+	if err != nil {
+		log.Debugf("Policy group Delete: Unable to delete Policy group with ID: [%s] . Error: %s ", pg.ID, err)
+		return err
+	}
+
+	log.Debugf("Policy group Delete: Deleted Policy group with ID: [%s] ", pg.ID)
+	return nil
+}
+
+// Assumes the method receiver was allocated using "new(PolicyGroup)"
+// Caller must populate:
+// - Name (pg.Name)
+// - Parent Domain ID (pg.ParentID)
+// Optionally: Policy group template ID (pg.TemplateID)
+func (pg *PolicyGroup) Create(c *nuage.Connection) error {
+	if pg == nil {
+		err := fmt.Errorf("Policy group Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if pg.Name == "" {
+		err := fmt.Errorf("Policy group Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if pg.ParentID == "" {
+		err := fmt.Errorf("Policy group Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var pga [1]PolicyGroup
+	// XXX - This copies the supplied fields
+	pga[0] = *pg
+
+	jsonpg, _ := json.MarshalIndent(pga[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "domains/"+pg.ParentID+"/policygroups", jsonpg)
+
+	if err != nil {
+		log.Debugf("Policy group Create: Unable to create Policy group with name: [%s] . Error: %s ", pg.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &pga)
+
+	if err != nil {
+		log.Debugf("Policy group Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*pg = pga[0]
+	log.Debugf("Policy group Create: Created Policy group with ID: [%s]", pg.ID)
+	return nil
+}
+
+// Get by Policy group ID (pg.ID)
+func (pg *PolicyGroup) Get(c *nuage.Connection) error {
+	if pg.ID == "" {
+		err := fmt.Errorf("Policy group Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "policygroups/"+pg.ID)
+
+	if err != nil {
+		log.Debugf("Policy group Get: Unable to get Policy group with ID: [%s] . Error: %s ", pg.ID, err)
+		return err
+	}
+
+	var pga [1]PolicyGroup
+	err = json.Unmarshal(reply, &pga)
+	if err != nil {
+		log.Debugf("Policy group Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*pg = pga[0]
+	log.Debugf("Policy group Get: Found Policy group with Name: [%s] and ID: [%s]", pg.Name, pg.ID)
+	return nil
+}
+
+// Policy group list for a given Domain ID (or global list if empty)
+func (pgs *PolicyGroupslice) List(c *nuage.Connection, parentid string) error {
+	var reply []byte
+	var err error
+
+	if parentid == "" { // get global list
+		reply, err = nuage.GetEntity(c, "policygroups")
+	} else {
+		// get the list for a given Domain ID
+		reply, err = nuage.GetEntity(c, "domains/"+parentid+"/policygroups")
+	}
+
+	if err != nil {
+		log.Debugf("Policy group List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Policy group List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, pgs)
+
+	if err != nil {
+		log.Debugf("Policy group List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Policy group List: done")
+	return nil
+}
+
+// VPorts list for a Policy group.  Caller must initialize the Policy group ID (pg.ID)
+func (pg *PolicyGroup) VPortsList(c *nuage.Connection) ([]VPort, error) {
+
+	if pg.ID == "" {
+		err := fmt.Errorf("Policy group VPorts List: Empty Policy group ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "policygroups/"+pg.ID+"/vports")
+
+	if err != nil {
+		log.Debugf("Policy group VPorts List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Policy group VPorts List: Empty list")
+		return nil, nil
+	}
+
+	var vports []VPort
+
+	err = json.Unmarshal(reply, &vports)
+	if err != nil {
+		log.Debugf("Policy group VPorts List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("Policy group VPorts List: done")
+	return vports, nil
+
+}
+
+// Policy group members: Assign VPorts (by ID) to a Policy group. Caller must initialize the Policy group ID (pg.ID)
+func (pg *PolicyGroup) AssignVPorts(c *nuage.Connection, vportids ...string) error {
+	if pg.ID == "" {
+		err := fmt.Errorf("Policy group Assign VPorts: Empty Policy group ID, nothing to do")
+		return err
+	}
+
+	members, err := pg.VPortsList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, vp := range members {
+		ids = append(ids, vp.ID)
+	}
+
+	return assignmembers(c, "Policy group Assign VPorts", "policygroups/"+pg.ID+"/vports", ids, vportids)
+}
+
+// Policy group members: Unassign VPorts (by ID) from a Policy group. Caller must initialize the Policy group ID (pg.ID)
+func (pg *PolicyGroup) UnassignVPorts(c *nuage.Connection, vportids ...string) error {
+	if pg.ID == "" {
+		err := fmt.Errorf("Policy group Unassign VPorts: Empty Policy group ID, nothing to do")
+		return err
+	}
+
+	members, err := pg.VPortsList(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, vp := range members {
+		ids = append(ids, vp.ID)
+	}
+
+	return unassignmembers(c, "Policy group Unassign VPorts", "policygroups/"+pg.ID+"/vports", ids, vportids)
+}
+
+// Policy groups list for a VPort.  Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) PolicyGroupsList(c *nuage.Connection) ([]PolicyGroup, error) {
+
+	if vp.ID == "" {
+		err := fmt.Errorf("VPort Policy groups List: Empty VPort ID, nothing to do")
+		return nil, err
+	}
+
+	reply, err := nuage.GetEntity(c, "vports/"+vp.ID+"/policygroups")
+
+	if err != nil {
+		log.Debugf("VPort Policy groups List: Error %s ", err)
+		return nil, err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("VPort Policy groups List: Empty list")
+		return nil, nil
+	}
+
+	var policygroups []PolicyGroup
+
+	err = json.Unmarshal(reply, &policygroups)
+	if err != nil {
+		log.Debugf("VPort Policy groups List:  Unable to decode JSON payload: %s ", err)
+		return nil, err
+	}
+
+	log.Debug("VPort Policy groups List: done")
+	return policygroups, nil
+
+}
+
+////////
+//////// Policy group template methods
+////////
+
+// Caller must populate the Policy group template ID (pgt.ID)
+func (pgt *PolicyGrouptemplate) Delete(c *nuage.Connection) error {
+	if pgt == nil {
+		err := fmt.Errorf("Policy group template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if pgt.ID == "" {
+		err := fmt.Errorf("Policy group template Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "policygrouptemplates", pgt.ID)
+
+	if err != nil {
+		log.Debugf("Policy group template Delete: Unable to delete Policy group template with ID: [%s] . Error: %s ", pgt.ID, err)
+		return err
+	}
+
+	log.Debugf("Policy group template Delete: Deleted Policy group template with ID: [%s] ", pgt.ID)
+	return nil
+}
+
+// Assumes the method receiver was allocated using "new(PolicyGrouptemplate)"
+// Caller must populate:
+// - Name (pgt.Name)
+// - Parent Domain template ID (pgt.ParentID)
+func (pgt *PolicyGrouptemplate) Create(c *nuage.Connection) error {
+	if pgt == nil {
+		err := fmt.Errorf("Policy group template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if pgt.Name == "" {
+		err := fmt.Errorf("Policy group template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if pgt.ParentID == "" {
+		err := fmt.Errorf("Policy group template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var pgta [1]PolicyGrouptemplate
+	// XXX - This copies the supplied fields
+	pgta[0] = *pgt
+
+	jsonpgt, _ := json.MarshalIndent(pgta[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "domaintemplates/"+pgt.ParentID+"/policygrouptemplates", jsonpgt)
+
+	if err != nil {
+		log.Debugf("Policy group template Create: Unable to create Policy group template with name: [%s] . Error: %s ", pgt.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &pgta)
+
+	if err != nil {
+		log.Debugf("Policy group template Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*pgt = pgta[0]
+	log.Debugf("Policy group template Create: Created Policy group template with ID: [%s]", pgt.ID)
+	return nil
+}
+
+// Get by Policy group template ID (pgt.ID)
+func (pgt *PolicyGrouptemplate) Get(c *nuage.Connection) error {
+	if pgt.ID == "" {
+		err := fmt.Errorf("Policy group template Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "policygrouptemplates/"+pgt.ID)
+
+	if err != nil {
+		log.Debugf("Policy group template Get: Unable to get Policy group template with ID: [%s] . Error: %s ", pgt.ID, err)
+		return err
+	}
+
+	var pgta [1]PolicyGrouptemplate
+	err = json.Unmarshal(reply, &pgta)
+	if err != nil {
+		log.Debugf("Policy group template Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*pgt = pgta[0]
+	log.Debugf("Policy group template Get: Found Policy group template with Name: [%s] and ID: [%s]", pgt.Name, pgt.ID)
+	return nil
+}
+
+// Policy group template list for a given Domain template ID
+func (pgts *PolicyGrouptemplateslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Policy group template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "domaintemplates/"+parentid+"/policygrouptemplates")
+
+	if err != nil {
+		log.Debugf("Policy group template List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Policy group template List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, pgts)
+
+	if err != nil {
+		log.Debugf("Policy group template List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Policy group template List: done")
+	return nil
+}
+
+////////
+//////// Ingress ACL template methods
+////////
+
+// Assumes the method receiver was allocated using "new(IngressACLTemplate)"
+// Caller must populate:
+// - Name (iacl.Name)
+// - Parent ID (iacl.ParentID)
+// - Parent Type (iacl.ParentType): "domain", "domaintemplate", "l2domain" or "l2domaintemplate"
+func (iacl *IngressACLTemplate) Create(c *nuage.Connection) error {
+	if iacl == nil {
+		err := fmt.Errorf("Ingress ACL template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if iacl.Name == "" {
+		err := fmt.Errorf("Ingress ACL template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if iacl.ParentID == "" {
+		err := fmt.Errorf("Ingress ACL template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validaclparent(iacl.ParentType) {
+		err := fmt.Errorf("Ingress ACL template Create: Invalid ParentType: [%s]", iacl.ParentType)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var iacla [1]IngressACLTemplate
+	// XXX - This copies the supplied fields
+	iacla[0] = *iacl
+
+	jsoniacl, _ := json.MarshalIndent(iacla[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, iacl.ParentType+"s/"+iacl.ParentID+"/ingressacltemplates", jsoniacl)
+
+	if err != nil {
+		log.Debugf("Ingress ACL template Create: Unable to create Ingress ACL template with name: [%s] . Error: %s ", iacl.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &iacla)
+
+	if err != nil {
+		log.Debugf("Ingress ACL template Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*iacl = iacla[0]
+	log.Debugf("Ingress ACL template Create: Created Ingress ACL template with ID: [%s]", iacl.ID)
+	return nil
+}
+
+// Get by Ingress ACL template ID (iacl.ID)
+func (iacl *IngressACLTemplate) Get(c *nuage.Connection) error {
+	if iacl.ID == "" {
+		err := fmt.Errorf("Ingress ACL template Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ingressacltemplates/"+iacl.ID)
+
+	if err != nil {
+		log.Debugf("Ingress ACL template Get: Unable to get Ingress ACL template with ID: [%s] . Error: %s ", iacl.ID, err)
+		return err
+	}
+
+	var iacla [1]IngressACLTemplate
+	err = json.Unmarshal(reply, &iacla)
+	if err != nil {
+		log.Debugf("Ingress ACL template Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*iacl = iacla[0]
+	log.Debugf("Ingress ACL template Get: Found Ingress ACL template with Name: [%s] and ID: [%s]", iacl.Name, iacl.ID)
+	return nil
+}
+
+// Ingress ACL template list for a given parent. Parent type is one of "domain", "domaintemplate", "l2domain" or "l2domaintemplate"
+func (iacls *IngressACLTemplateslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Ingress ACL template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validaclparent(parenttype) {
+		err := fmt.Errorf("Ingress ACL template List: Invalid parent type: [%s]", parenttype)
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/ingressacltemplates")
+
+	if err != nil {
+		log.Debugf("Ingress ACL template List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Ingress ACL template List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, iacls)
+
+	if err != nil {
+		log.Debugf("Ingress ACL template List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Ingress ACL template List: done")
+	return nil
+}
+
+// Caller must populate the Ingress ACL template ID (iacl.ID)
+func (iacl *IngressACLTemplate) Delete(c *nuage.Connection) error {
+	if iacl == nil {
+		err := fmt.Errorf("Ingress ACL template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if iacl.ID == "" {
+		err := fmt.Errorf("Ingress ACL template Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "ingressacltemplates", iacl.ID)
+
+	if err != nil {
+		log.Debugf("Ingress ACL template Delete: Unable to delete Ingress ACL template with ID: [%s] . Error: %s ", iacl.ID, err)
+		return err
+	}
+
+	log.Debugf("Ingress ACL template Delete: Deleted Ingress ACL template with ID: [%s] ", iacl.ID)
+	return nil
+}
+
+////////
+//////// Egress ACL template methods
+////////
+
+// Assumes the method receiver was allocated using "new(EgressACLTemplate)"
+// Caller must populate:
+// - Name (eacl.Name)
+// - Parent ID (eacl.ParentID)
+// - Parent Type (eacl.ParentType): "domain", "domaintemplate", "l2domain" or "l2domaintemplate"
+func (eacl *EgressACLTemplate) Create(c *nuage.Connection) error {
+	if eacl == nil {
+		err := fmt.Errorf("Egress ACL template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if eacl.Name == "" {
+		err := fmt.Errorf("Egress ACL template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if eacl.ParentID == "" {
+		err := fmt.Errorf("Egress ACL template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validaclparent(eacl.ParentType) {
+		err := fmt.Errorf("Egress ACL template Create: Invalid ParentType: [%s]", eacl.ParentType)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var eacla [1]EgressACLTemplate
+	// XXX - This copies the supplied fields
+	eacla[0] = *eacl
+
+	jsoneacl, _ := json.MarshalIndent(eacla[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, eacl.ParentType+"s/"+eacl.ParentID+"/egressacltemplates", jsoneacl)
+
+	if err != nil {
+		log.Debugf("Egress ACL template Create: Unable to create Egress ACL template with name: [%s] . Error: %s ", eacl.Name, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &eacla)
+
+	if err != nil {
+		log.Debugf("Egress ACL template Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*eacl = eacla[0]
+	log.Debugf("Egress ACL template Create: Created Egress ACL template with ID: [%s]", eacl.ID)
+	return nil
+}
+
+// Get by Egress ACL template ID (eacl.ID)
+func (eacl *EgressACLTemplate) Get(c *nuage.Connection) error {
+	if eacl.ID == "" {
+		err := fmt.Errorf("Egress ACL template Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "egressacltemplates/"+eacl.ID)
+
+	if err != nil {
+		log.Debugf("Egress ACL template Get: Unable to get Egress ACL template with ID: [%s] . Error: %s ", eacl.ID, err)
+		return err
+	}
+
+	var eacla [1]EgressACLTemplate
+	err = json.Unmarshal(reply, &eacla)
+	if err != nil {
+		log.Debugf("Egress ACL template Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*eacl = eacla[0]
+	log.Debugf("Egress ACL template Get: Found Egress ACL template with Name: [%s] and ID: [%s]", eacl.Name, eacl.ID)
+	return nil
+}
+
+// Egress ACL template list for a given parent. Parent type is one of "domain", "domaintemplate", "l2domain" or "l2domaintemplate"
+func (eacls *EgressACLTemplateslice) List(c *nuage.Connection, parenttype, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Egress ACL template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if !validaclparent(parenttype) {
+		err := fmt.Errorf("Egress ACL template List: Invalid parent type: [%s]", parenttype)
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, parenttype+"s/"+parentid+"/egressacltemplates")
+
+	if err != nil {
+		log.Debugf("Egress ACL template List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Egress ACL template List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, eacls)
+
+	if err != nil {
+		log.Debugf("Egress ACL template List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Egress ACL template List: done")
+	return nil
+}
+
+// Caller must populate the Egress ACL template ID (eacl.ID)
+func (eacl *EgressACLTemplate) Delete(c *nuage.Connection) error {
+	if eacl == nil {
+		err := fmt.Errorf("Egress ACL template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if eacl.ID == "" {
+		err := fmt.Errorf("Egress ACL template Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "egressacltemplates", eacl.ID)
+
+	if err != nil {
+		log.Debugf("Egress ACL template Delete: Unable to delete Egress ACL template with ID: [%s] . Error: %s ", eacl.ID, err)
+		return err
+	}
+
+	log.Debugf("Egress ACL template Delete: Deleted Egress ACL template with ID: [%s] ", eacl.ID)
+	return nil
+}
+
+////////
+//////// Ingress ACL entry methods
+////////
+
+// Assumes the method receiver was allocated using "new(IngressACLEntry)"
+// Caller must populate:
+// - Parent Ingress ACL template ID (iace.ParentID)
+// - Priority (iace.Priority)
+// - Action (iace.Action): "FORWARD" or "DROP"
+// Defaults (if empty): Protocol "ANY", EtherType "0x0800" (IPv4), NetworkType / LocationType "ANY"
+func (iace *IngressACLEntry) Create(c *nuage.Connection) error {
+	if iace == nil {
+		err := fmt.Errorf("Ingress ACL entry Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if iace.ParentID == "" {
+		err := fmt.Errorf("Ingress ACL entry Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if iace.Protocol == "" {
+		iace.Protocol = "ANY"
+	}
+
+	if iace.EtherType == "" {
+		iace.EtherType = "0x0800"
+	}
+
+	if iace.NetworkType == "" {
+		iace.NetworkType = "ANY"
+	}
+
+	if iace.LocationType == "" {
+		iace.LocationType = "ANY"
+	}
+
+	err := validaclentry(iace.Priority, iace.Action, iace.Protocol, iace.SourcePort, iace.DestinationPort, iace.NetworkType, iace.NetworkID, iace.LocationType, iace.LocationID)
+	if err != nil {
+		err = fmt.Errorf("Ingress ACL entry Create: %s", err)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var iacea [1]IngressACLEntry
+	// XXX - This copies the supplied fields
+	iacea[0] = *iace
+
+	jsoniace, _ := json.MarshalIndent(iacea[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "ingressacltemplates/"+iace.ParentID+"/ingressaclentrytemplates", jsoniace)
+
+	if err != nil {
+		log.Debugf("Ingress ACL entry Create: Unable to create Ingress ACL entry with priority: [%d] . Error: %s ", iace.Priority, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &iacea)
+
+	if err != nil {
+		log.Debugf("Ingress ACL entry Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*iace = iacea[0]
+	log.Debugf("Ingress ACL entry Create: Created Ingress ACL entry with ID: [%s]", iace.ID)
+	return nil
+}
+
+// Get by Ingress ACL entry ID (iace.ID)
+func (iace *IngressACLEntry) Get(c *nuage.Connection) error {
+	if iace.ID == "" {
+		err := fmt.Errorf("Ingress ACL entry Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ingressaclentrytemplates/"+iace.ID)
+
+	if err != nil {
+		log.Debugf("Ingress ACL entry Get: Unable to get Ingress ACL entry with ID: [%s] . Error: %s ", iace.ID, err)
+		return err
+	}
+
+	var iacea [1]IngressACLEntry
+	err = json.Unmarshal(reply, &iacea)
+	if err != nil {
+		log.Debugf("Ingress ACL entry Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*iace = iacea[0]
+	log.Debugf("Ingress ACL entry Get: Found Ingress ACL entry with Description: [%s] and ID: [%s]", iace.Description, iace.ID)
+	return nil
+}
+
+// Ingress ACL entry list for a given Ingress ACL template ID
+func (iaces *IngressACLEntryslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Ingress ACL entry List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "ingressacltemplates/"+parentid+"/ingressaclentrytemplates")
+
+	if err != nil {
+		log.Debugf("Ingress ACL entry List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Ingress ACL entry List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, iaces)
+
+	if err != nil {
+		log.Debugf("Ingress ACL entry List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Ingress ACL entry List: done")
+	return nil
+}
+
+// Caller must populate the Ingress ACL entry ID (iace.ID)
+func (iace *IngressACLEntry) Delete(c *nuage.Connection) error {
+	if iace == nil {
+		err := fmt.Errorf("Ingress ACL entry Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if iace.ID == "" {
+		err := fmt.Errorf("Ingress ACL entry Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "ingressaclentrytemplates", iace.ID)
+
+	if err != nil {
+		log.Debugf("Ingress ACL entry Delete: Unable to delete Ingress ACL entry with ID: [%s] . Error: %s ", iace.ID, err)
+		return err
+	}
+
+	log.Debugf("Ingress ACL entry Delete: Deleted Ingress ACL entry with ID: [%s] ", iace.ID)
+	return nil
+}
+
+////////
+//////// Egress ACL entry methods
+////////
+
+// Assumes the method receiver was allocated using "new(EgressACLEntry)"
+// Caller must populate:
+// - Parent Egress ACL template ID (eace.ParentID)
+// - Priority (eace.Priority)
+// - Action (eace.Action): "FORWARD" or "DROP"
+// Defaults (if empty): Protocol "ANY", EtherType "0x0800" (IPv4), NetworkType / LocationType "ANY"
+func (eace *EgressACLEntry) Create(c *nuage.Connection) error {
+	if eace == nil {
+		err := fmt.Errorf("Egress ACL entry Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if eace.ParentID == "" {
+		err := fmt.Errorf("Egress ACL entry Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if eace.Protocol == "" {
+		eace.Protocol = "ANY"
+	}
+
+	if eace.EtherType == "" {
+		eace.EtherType = "0x0800"
+	}
+
+	if eace.NetworkType == "" {
+		eace.NetworkType = "ANY"
+	}
+
+	if eace.LocationType == "" {
+		eace.LocationType = "ANY"
+	}
+
+	err := validaclentry(eace.Priority, eace.Action, eace.Protocol, eace.SourcePort, eace.DestinationPort, eace.NetworkType, eace.NetworkID, eace.LocationType, eace.LocationID)
+	if err != nil {
+		err = fmt.Errorf("Egress ACL entry Create: %s", err)
+		return err
+	}
+
+	// It has to be an array since the reply from the server is as an array of JSON objects, and we use it for decoding as well
+	var eacea [1]EgressACLEntry
+	// XXX - This copies the supplied fields
+	eacea[0] = *eace
+
+	jsoneace, _ := json.MarshalIndent(eacea[0], "", "\t")
+	reply, err := nuage.CreateEntity(c, "egressacltemplates/"+eace.ParentID+"/egressaclentrytemplates", jsoneace)
+
+	if err != nil {
+		log.Debugf("Egress ACL entry Create: Unable to create Egress ACL entry with priority: [%d] . Error: %s ", eace.Priority, err)
+		return err
+	}
+
+	err = json.Unmarshal(reply, &eacea)
+
+	if err != nil {
+		log.Debugf("Egress ACL entry Create: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*eace = eacea[0]
+	log.Debugf("Egress ACL entry Create: Created Egress ACL entry with ID: [%s]", eace.ID)
+	return nil
+}
+
+// Get by Egress ACL entry ID (eace.ID)
+func (eace *EgressACLEntry) Get(c *nuage.Connection) error {
+	if eace.ID == "" {
+		err := fmt.Errorf("Egress ACL entry Get: Empty ID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "egressaclentrytemplates/"+eace.ID)
+
+	if err != nil {
+		log.Debugf("Egress ACL entry Get: Unable to get Egress ACL entry with ID: [%s] . Error: %s ", eace.ID, err)
+		return err
+	}
+
+	var eacea [1]EgressACLEntry
+	err = json.Unmarshal(reply, &eacea)
+	if err != nil {
+		log.Debugf("Egress ACL entry Get: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+
+	// XXX - Mutate the receiver
+	*eace = eacea[0]
+	log.Debugf("Egress ACL entry Get: Found Egress ACL entry with Description: [%s] and ID: [%s]", eace.Description, eace.ID)
+	return nil
+}
+
+// Egress ACL entry list for a given Egress ACL template ID
+func (eaces *EgressACLEntryslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Egress ACL entry List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	reply, err := nuage.GetEntity(c, "egressacltemplates/"+parentid+"/egressaclentrytemplates")
+
+	if err != nil {
+		log.Debugf("Egress ACL entry List: Unable to obtain list: %s ", err)
+		return err
+	}
+
+	if len(reply) == 0 {
+		log.Debugf("Egress ACL entry List: Empty list")
+		return nil
+	}
+
+	err = json.Unmarshal(reply, eaces)
+
+	if err != nil {
+		log.Debugf("Egress ACL entry List: Unable to decode JSON payload: %s ", err)
+		return err
+	}
+	log.Debug("Egress ACL entry List: done")
+	return nil
+}
+
+// Caller must populate the Egress ACL entry ID (eace.ID)
+func (eace *EgressACLEntry) Delete(c *nuage.Connection) error {
+	if eace == nil {
+		err := fmt.Errorf("Egress ACL entry Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if eace.ID == "" {
+		err := fmt.Errorf("Egress ACL entry Delete: Empty ID, nothing to do")
+		return err
+	}
+	_, err := nuage.DeleteEntity(c, "egressaclentrytemplates", eace.ID)
+
+	if err != nil {
+		log.Debugf("Egress ACL entry Delete: Unable to delete Egress ACL entry with ID: [%s] . Error: %s ", eace.ID, err)
+		return err
+	}
+
+	log.Debugf("Egress ACL entry Delete: Deleted Egress ACL entry with ID: [%s] ", eace.ID)
+	return nil
+}
+
+////////
+//////// ACL templates and entries -- auxiliary functions. Unexported
+////////
+
+// ACL templates can be attached to L3 / L2 domains or their templates
+func validaclparent(parenttype string) bool {
+	switch parenttype {
+	case "domain", "domaintemplate", "l2domain", "l2domaintemplate":
+		return true
+	}
+	return false
+}
+
+// Sanity checks for an ACL entry. Protocol and ports: see "validprotoports"
+func validaclentry(priority int, action, protocol, srcport, dstport, networktype, networkid, locationtype, locationid string) error {
+	if priority < 0 {
+		return fmt.Errorf("Invalid priority: [%d]", priority)
+	}
+
+	switch action {
+	case "FORWARD", "DROP":
+	default:
+		return fmt.Errorf("Invalid action: [%s]. Must be one of: FORWARD, DROP", action)
+	}
+
+	if err := validprotoports(protocol, srcport, dstport); err != nil {
+		return err
+	}
+
+	if networktype != "ANY" && networkid == "" {
+		return fmt.Errorf("Network type: [%s] requires a network ID", networktype)
+	}
+
+	if locationtype != "ANY" && locationid == "" {
+		return fmt.Errorf("Location type: [%s] requires a location ID", locationtype)
+	}
+
+	return nil
+}
+
+// Sanity checks for an Ingress advanced forwarding entry. As for ACL entries (see "validaclentry"), plus the "REDIRECT" action -- which, and only which, takes a Redirection target ID
+func validadvfwdentry(afwde *IngressAdvFwdEntry) error {
+	switch afwde.Action {
+	case "REDIRECT":
+		if afwde.RedirectVPortTagID == "" {
+			return fmt.Errorf("Action: [REDIRECT] requires a Redirection target ID")
+		}
+	case "FORWARD", "DROP":
+		if afwde.RedirectVPortTagID != "" {
+			return fmt.Errorf("A Redirection target requires action: [REDIRECT], not: [%s]", afwde.Action)
+		}
+	default:
+		return fmt.Errorf("Invalid action: [%s]. Must be one of: FORWARD, DROP, REDIRECT", afwde.Action)
+	}
+
+	// The action was checked above
+	return validaclentry(afwde.Priority, "FORWARD", afwde.Protocol, afwde.SourcePort, afwde.DestinationPort, afwde.NetworkType, afwde.NetworkID, afwde.LocationType, afwde.LocationID)
+}
+
+// Protocol and ports of ACL entries and Application services. The protocol is "ANY" or an IANA protocol number. Ports are optional,
+// only valid for TCP ("6") and UDP ("17"), and have the form "*", "<port>" or "<port>-<port>"
+func validprotoports(protocol, srcport, dstport string) error {
+	if protocol != "ANY" {
+		proto, err := strconv.Atoi(protocol)
+		if err != nil || proto < 0 || proto > 255 {
+			return fmt.Errorf("Invalid protocol: [%s]. Must be \"ANY\" or an IANA protocol number (0-255)", protocol)
+		}
+	}
+
+	for _, port := range []string{srcport, dstport} {
+		if port == "" {
+			continue
+		}
+		if protocol != "6" && protocol != "17" {
+			return fmt.Errorf("Ports are only valid for TCP (6) and UDP (17), not protocol: [%s]", protocol)
+		}
+		if !validaclport(port) {
+			return fmt.Errorf("Invalid port: [%s]. Must be \"*\", a port number or a port range (e.g. \"1024-2048\")", port)
+		}
+	}
+
+	return nil
+}
+
+// "*", "<port>" or "<port>-<port>", lowest first. Port numbers are plain decimal digits ("strconv.Atoi" alone also accepts e.g. "+80")
+func validaclport(port string) bool {
+	if port == "*" {
+		return true
+	}
+
+	ports := strings.Split(port, "-")
+	if len(ports) > 2 {
+		return false
+	}
+
+	prev := 0
+	for _, p := range ports {
+		if strings.TrimLeft(p, "0123456789") != "" {
+			return false
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 || n > 65535 || n < prev {
+			return false
+		}
+		prev = n
+	}
+	return true
+}
+
+////////
+//////// Member lists (e.g. Policy group VPorts, Group Users) -- auxiliary functions. Unexported
+//////// The VSD replaces the whole member list on update, so callers read the current members first and pass their IDs as "members"
+////////
+
+// Add members (by ID) to the member list at "endpoint" (e.g. "policygroups/<ID>/vports"). IDs already in the list are skipped
+func assignmembers(c *nuage.Connection, label, endpoint string, members, add []string) error {
+	ids := members
+Next:
+	for _, id := range add {
+		for _, member := range ids {
+			if member == id {
+				log.Debugf("%s: ID: [%s] already a member of [%s]", label, id, endpoint)
+				continue Next
+			}
+		}
+		ids = append(ids, id)
+	}
+
+	return setmembers(c, label, endpoint, ids)
+}
+
+// Remove members (by ID) from the member list at "endpoint". Fails if none of them is a member
+func unassignmembers(c *nuage.Connection, label, endpoint string, members, remove []string) error {
+	ids := []string{}
+Next:
+	for _, member := range members {
+		for _, id := range remove {
+			if member == id {
+				continue Next
+			}
+		}
+		ids = append(ids, member)
+	}
+
+	if len(ids) == len(members) {
+		err := fmt.Errorf("%s: None of the IDs %v is a member of [%s]", label, remove, endpoint)
+		return err
+	}
+
+	return setmembers(c, label, endpoint, ids)
+}
+
+// Replace the whole member list at "endpoint"
+func setmembers(c *nuage.Connection, label, endpoint string, ids []string) error {
+	jsonids, _ := json.Marshal(ids)
+	_, err := nuage.UpdateEntity(c, endpoint, jsonids)
+
+	if err != nil {
+		log.Debugf("%s: Unable to update [%s] . Error: %s ", label, endpoint, err)
+		return err
+	}
+
+	log.Debugf("%s: [%s] now has members: %v", label, endpoint, ids)
+	return nil
+}
+
 ////////
 //////// Enterprise methods
 ////////
@@ -0,0 +1,597 @@
+package nuage_v3_2
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	nuage "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage"
+)
+
+////////
+//////// Fake VSD: Answers the API versions probe and authentication, then "GET /nuage/api/v3_2/<path>" with the next of a scripted sequence of replies for that path -- the last one repeated
+////////
+
+type fakevsd struct {
+	sync.Mutex
+	replies map[string][]string // By path, relative to "/nuage/api/v3_2/"
+	gets    map[string]int
+}
+
+func (f *fakevsd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch {
+	case r.URL.Path == "/nuage":
+		fmt.Fprint(w, `{"versions":[{"version":"v3.2","status":"CURRENT"}]}`)
+		return
+	case r.URL.Path == "/nuage/api/v1_0/me":
+		fmt.Fprint(w, `[{"APIKey":"key","userName":"user","enterpriseName":"org"}]`)
+		return
+	case r.Method != "GET" || !strings.HasPrefix(r.URL.Path, "/nuage/api/v3_2/"):
+		w.WriteHeader(404)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/nuage/api/v3_2/")
+	replies, found := f.replies[path]
+	if !found || len(replies) == 0 {
+		w.WriteHeader(404)
+		return
+	}
+
+	n := f.gets[path]
+	f.gets[path]++
+	if n >= len(replies) {
+		n = len(replies) - 1
+	}
+	fmt.Fprint(w, replies[n])
+}
+
+// A connection to a fake VSD serving "replies". Caller must close the server
+func fakeconn(t *testing.T, replies map[string][]string) (*nuage.Connection, *httptest.Server) {
+	if _, found := replies["vsps"]; !found {
+		// No VSD release
+		replies["vsps"] = []string{""}
+	}
+	srv := httptest.NewTLSServer(&fakevsd{replies: replies, gets: make(map[string]int)})
+	c := &nuage.Connection{Url: srv.URL, Apivers: "v3_2"}
+	if err := c.Connect("org", "user", "pass"); err != nil {
+		srv.Close()
+		t.Fatalf("Connect: %s", err)
+	}
+	return c, srv
+}
+
+////////
+//////// DHCP options
+////////
+
+func TestDHCPOptionRoundTrip(t *testing.T) {
+	tests := []struct {
+		code   int
+		values []string
+		hex    string   // Expected Value
+		want   []string // Expected decoded values. Defaults to "values"
+	}{
+		{1, []string{"255.255.255.0"}, "ffffff00", nil},
+		{6, []string{"10.0.0.1", "10.0.0.2"}, "0a0000010a000002", nil},
+		{15, []string{"example.com"}, hex.EncodeToString([]byte("example.com")), nil},
+		{26, []string{"1500"}, "05dc", nil},
+		{51, []string{"86400"}, "00015180", nil},
+		{121, []string{"0.0.0.0/0,10.0.0.1"}, "000a000001", nil},
+		{121, []string{"10.1.2.3/32,10.0.0.1"}, "200a0102030a000001", nil},
+		{121, []string{"10.0.128.0/17,10.0.0.1"}, "110a00800a000001", nil},
+		{121, []string{"172.16.0.0/12,10.0.0.1", "192.168.1.0/24,10.0.0.2"}, "0cac100a00000118c0a8010a000002", nil},
+		// Host bits are dropped from the destination prefix
+		{121, []string{"10.1.2.3/24,10.0.0.1"}, "180a01020a000001", []string{"10.1.2.0/24,10.0.0.1"}},
+		{200, []string{"0a0b0c"}, "0a0b0c", nil},
+	}
+
+	for _, tt := range tests {
+		var o DHCPOption
+		if err := o.Encode(tt.code, tt.values...); err != nil {
+			t.Errorf("Encode(%d, %v): %s", tt.code, tt.values, err)
+			continue
+		}
+		if o.Value != tt.hex {
+			t.Errorf("Encode(%d, %v): got Value [%s], want [%s]", tt.code, tt.values, o.Value, tt.hex)
+		}
+
+		code, values, err := o.Decode()
+		if err != nil {
+			t.Errorf("Decode of Encode(%d, %v): %s", tt.code, tt.values, err)
+			continue
+		}
+		want := tt.want
+		if want == nil {
+			want = tt.values
+		}
+		if code != tt.code || !reflect.DeepEqual(values, want) {
+			t.Errorf("Decode of Encode(%d, %v): got %d %v, want %d %v", tt.code, tt.values, code, values, tt.code, want)
+		}
+	}
+}
+
+func TestDHCPOptionEncodeInvalid(t *testing.T) {
+	tests := []struct {
+		code   int
+		values []string
+	}{
+		{0, []string{"10.0.0.1"}},
+		{255, []string{"10.0.0.1"}},
+		{6, nil},
+		{1, []string{"255.255.255.0", "255.255.0.0"}},
+		{3, []string{"fe80::1"}},
+		{26, []string{"65536"}},
+		{51, []string{"-1"}},
+		{121, []string{"10.0.0.0/8"}},
+		{121, []string{"10.0.0.0/33,10.0.0.1"}},
+		{121, []string{"2001:db8::/32,10.0.0.1"}},
+		{200, []string{"0g"}},
+		{200, []string{"abc"}},
+		{200, []string{"0a", "0b"}},
+	}
+
+	for _, tt := range tests {
+		var o DHCPOption
+		if err := o.Encode(tt.code, tt.values...); err == nil {
+			t.Errorf("Encode(%d, %v) should fail, got Value [%s]", tt.code, tt.values, o.Value)
+		}
+	}
+}
+
+func TestDHCPOptionDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		typ, value string
+	}{
+		{"zz", "0a000001"},
+		{"06", "0a00000"},  // Odd number of hex digits
+		{"06", "0a0000zz"}, // Not hex
+		{"06", "0a0000"},   // Not a multiple of 4 bytes
+		{"1a", "05dc00"},
+		{"79", "210a0000010a000001"}, // Prefix length 33
+		{"79", "180a0102"},           // Truncated
+	}
+
+	for _, tt := range tests {
+		o := DHCPOption{Type: tt.typ, Value: tt.value}
+		if _, values, err := o.Decode(); err == nil {
+			t.Errorf("Decode of type [%s] value [%s] should fail, got %v", tt.typ, tt.value, values)
+		}
+	}
+}
+
+func TestClasslessRoute(t *testing.T) {
+	tests := []struct {
+		route string
+		data  []byte
+	}{
+		{"0.0.0.0/0,192.168.0.1", []byte{0, 192, 168, 0, 1}},
+		{"10.0.0.0/8,192.168.0.1", []byte{8, 10, 192, 168, 0, 1}},
+		{"10.64.0.0/10,192.168.0.1", []byte{10, 10, 64, 192, 168, 0, 1}},
+		{"10.1.2.128/25,192.168.0.1", []byte{25, 10, 1, 2, 128, 192, 168, 0, 1}},
+		{"10.1.2.3/32,192.168.0.1", []byte{32, 10, 1, 2, 3, 192, 168, 0, 1}},
+	}
+
+	for _, tt := range tests {
+		data, err := encodeclasslessroute(tt.route)
+		if err != nil {
+			t.Errorf("encodeclasslessroute(%s): %s", tt.route, err)
+			continue
+		}
+		if !reflect.DeepEqual(data, tt.data) {
+			t.Errorf("encodeclasslessroute(%s): got %v, want %v", tt.route, data, tt.data)
+		}
+
+		// Trailing data is returned as is
+		route, rest, err := decodeclasslessroute(append(data, 0xff))
+		if err != nil {
+			t.Errorf("decodeclasslessroute(%v): %s", data, err)
+			continue
+		}
+		if route != tt.route || !reflect.DeepEqual(rest, []byte{0xff}) {
+			t.Errorf("decodeclasslessroute(%v): got [%s] %v, want [%s] [255]", data, route, rest, tt.route)
+		}
+	}
+}
+
+////////
+//////// QoS -- rates and burst sizes
+////////
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		rate, want string
+		ok         bool
+	}{
+		{"100", "100", true},
+		{"100Mbps", "100", true},
+		{"100 mbps", "100", true},
+		{"1.5Gbps", "1500", true},
+		{"512kbps", "0.512", true},
+		{"2000000bps", "2", true},
+		{"0", "0", true},
+		{"INFINITY", "INFINITY", true},
+		{"inf", "INFINITY", true},
+		{" Infinity ", "INFINITY", true},
+		{"", "", false},
+		{"-1", "", false},
+		{"-1Mbps", "", false},
+		{"10Tbps", "", false},
+		{"10MB", "", false},
+		{"Mbps", "", false},
+		{"NaN", "", false},
+		{"+Inf", "", false},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseRate(tt.rate)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("ParseRate(%q): got [%s], error: %v. Want [%s], ok: %t", tt.rate, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseBurst(t *testing.T) {
+	tests := []struct {
+		burst, want string
+		ok          bool
+	}{
+		{"100", "100", true},
+		{"100KB", "100", true},
+		{"1MB", "1024", true},
+		{"1 mb", "1024", true},
+		{"2GB", "2097152", true},
+		{"", "", false},
+		{"0", "", false},
+		{"-1KB", "", false},
+		{"1.5MB", "", false},
+		{"1TB", "", false},
+		{"1Mbps", "", false},
+		{"INFINITY", "", false},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBurst(tt.burst)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("ParseBurst(%q): got [%s], error: %v. Want [%s], ok: %t", tt.burst, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestValidRatePair(t *testing.T) {
+	tests := []struct {
+		peak, committed string
+		ok              bool
+	}{
+		{"", "", true},
+		{"100", "", true},
+		{"", "100", true},
+		{"100", "100", true},
+		{"100", "50", true},
+		{"100", "0", true},
+		{"1.5", "0.512", true},
+		{"INFINITY", "100", true},
+		{"INFINITY", "INFINITY", true},
+		{"50", "100", false},
+		{"0.5", "0.512", false},
+		{"100", "INFINITY", false},
+		{"-1", "", false},
+		{"100", "-1", false},
+		{"100Mbps", "50", false},
+		{"inf", "50", false},
+		{"NaN", "50", false},
+	}
+
+	for _, tt := range tests {
+		if err := validratepair(tt.peak, tt.committed); (err == nil) != tt.ok {
+			t.Errorf("validratepair(%q, %q): got error: %v, want ok: %t", tt.peak, tt.committed, err, tt.ok)
+		}
+	}
+}
+
+////////
+//////// Static routes
+////////
+
+func TestValidStaticRoute(t *testing.T) {
+	tests := []struct {
+		address, netmask, nexthop, routetype string
+		ok                                   bool
+	}{
+		{"10.1.0.0", "255.255.0.0", "192.168.0.1", "OVERLAY", true},
+		{"10.1.2.3", "255.255.255.255", "192.168.0.1", "EXIT_DOMAIN", true},
+		{"10.1.128.0", "255.255.128.0", "192.168.0.1", "OVERLAY", true},
+		{"0.0.0.0", "0.0.0.0", "192.168.0.1", "OVERLAY", true},
+		{"10.1.0.0", "255.255.0.0", "192.168.0.1", "", false},
+		{"10.1.0.0", "255.255.0.0", "192.168.0.1", "overlay", false},
+		// Non-contiguous masks
+		{"10.0.0.0", "255.0.255.0", "192.168.0.1", "OVERLAY", false},
+		{"10.0.0.0", "255.255.255.1", "192.168.0.1", "OVERLAY", false},
+		{"10.0.0.0", "0.255.255.255", "192.168.0.1", "OVERLAY", false},
+		// Host bits set
+		{"10.1.2.3", "255.255.0.0", "192.168.0.1", "OVERLAY", false},
+		{"10.1.129.0", "255.255.128.0", "192.168.0.1", "OVERLAY", false},
+		{"0.0.0.1", "0.0.0.0", "192.168.0.1", "OVERLAY", false},
+		// IPv6, or IPv4 and IPv6 mixed
+		{"2001:db8::", "ffff:ffff::", "2001:db8:1::1", "OVERLAY", false},
+		{"2001:db8::", "255.255.0.0", "192.168.0.1", "OVERLAY", false},
+		{"10.1.0.0", "ffff:ffff::", "192.168.0.1", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "2001:db8::1", "OVERLAY", false},
+		{"::ffff:10.1.0.0", "255.255.0.0", "192.168.0.1", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "::ffff:192.168.0.1", "OVERLAY", false},
+		// Next hop
+		{"10.1.0.0", "255.255.0.0", "10.1.0.1", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "0.0.0.0", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "127.0.0.1", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "224.0.0.1", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "255.255.255.255", "OVERLAY", false},
+		{"10.1.0.0", "255.255.0.0", "", "OVERLAY", false},
+	}
+
+	for _, tt := range tests {
+		if err := validstaticroute(tt.address, tt.netmask, tt.nexthop, tt.routetype); (err == nil) != tt.ok {
+			t.Errorf("validstaticroute(%s, %s, %s, %s): got error: %v, want ok: %t", tt.address, tt.netmask, tt.nexthop, tt.routetype, err, tt.ok)
+		}
+	}
+}
+
+////////
+//////// ACL entries
+////////
+
+func TestValidACLPort(t *testing.T) {
+	tests := []struct {
+		port string
+		ok   bool
+	}{
+		{"*", true},
+		{"80", true},
+		{"1", true},
+		{"65535", true},
+		{"1024-2048", true},
+		{"80-80", true},
+		{"80-79", false},
+		{"0", false},
+		{"0-80", false},
+		{"65536", false},
+		{"80-65536", false},
+		{"", false},
+		{"-80", false},
+		{"80-", false},
+		{"+80", false},
+		{"80-+90", false},
+		{"1-2-3", false},
+		{"http", false},
+		{"**", false},
+		{"*-80", false},
+	}
+
+	for _, tt := range tests {
+		if got := validaclport(tt.port); got != tt.ok {
+			t.Errorf("validaclport(%q): got %t, want %t", tt.port, got, tt.ok)
+		}
+	}
+}
+
+func TestValidACLEntry(t *testing.T) {
+	tests := []struct {
+		priority                   int
+		action, protocol, src, dst string
+		networktype, networkid     string
+		locationtype, locationid   string
+		ok                         bool
+	}{
+		{0, "FORWARD", "ANY", "", "", "ANY", "", "ANY", "", true},
+		{100, "DROP", "6", "*", "80", "ANY", "", "ANY", "", true},
+		{100, "FORWARD", "17", "1024-2048", "53", "ENTERPRISE_NETWORK", "id", "ZONE", "id", true},
+		{100, "FORWARD", "1", "", "", "ANY", "", "ANY", "", true},
+		{100, "FORWARD", "6", "", "80-79", "ANY", "", "ANY", "", false},
+		{100, "FORWARD", "6", "0", "", "ANY", "", "ANY", "", false},
+		// Ports given with a protocol other than TCP / UDP
+		{100, "FORWARD", "ANY", "", "80", "ANY", "", "ANY", "", false},
+		{100, "FORWARD", "1", "*", "", "ANY", "", "ANY", "", false},
+		{100, "FORWARD", "50", "", "*", "ANY", "", "ANY", "", false},
+		{-1, "FORWARD", "ANY", "", "", "ANY", "", "ANY", "", false},
+		{100, "ACCEPT", "ANY", "", "", "ANY", "", "ANY", "", false},
+		{100, "FORWARD", "256", "", "", "ANY", "", "ANY", "", false},
+		{100, "FORWARD", "tcp", "", "80", "ANY", "", "ANY", "", false},
+		{100, "FORWARD", "ANY", "", "", "SUBNET", "", "ANY", "", false},
+		{100, "FORWARD", "ANY", "", "", "ANY", "", "POLICYGROUP", "", false},
+	}
+
+	for _, tt := range tests {
+		err := validaclentry(tt.priority, tt.action, tt.protocol, tt.src, tt.dst, tt.networktype, tt.networkid, tt.locationtype, tt.locationid)
+		if (err == nil) != tt.ok {
+			t.Errorf("validaclentry(%d, %s, %s, %q, %q, ...): got error: %v, want ok: %t", tt.priority, tt.action, tt.protocol, tt.src, tt.dst, err, tt.ok)
+		}
+	}
+}
+
+func TestValidAdvFwdEntry(t *testing.T) {
+	tests := []struct {
+		action, target, protocol, dst string
+		ok                            bool
+	}{
+		{"REDIRECT", "rt-id", "ANY", "", true},
+		{"REDIRECT", "rt-id", "6", "80", true},
+		{"FORWARD", "", "ANY", "", true},
+		{"DROP", "", "17", "53", true},
+		// REDIRECT, and only REDIRECT, takes a Redirection target
+		{"REDIRECT", "", "ANY", "", false},
+		{"FORWARD", "rt-id", "ANY", "", false},
+		{"DROP", "rt-id", "ANY", "", false},
+		{"ACCEPT", "", "ANY", "", false},
+		{"", "", "ANY", "", false},
+		// Match criteria as for ACL entries
+		{"REDIRECT", "rt-id", "ANY", "80", false},
+	}
+
+	for _, tt := range tests {
+		e := &IngressAdvFwdEntry{Action: tt.action, RedirectVPortTagID: tt.target, Priority: 100, Protocol: tt.protocol, DestinationPort: tt.dst, NetworkType: "ANY", LocationType: "ANY"}
+		err := validadvfwdentry(e)
+		if (err == nil) != tt.ok {
+			t.Errorf("validadvfwdentry(%s, %q, %s, %q): got error: %v, want ok: %t", tt.action, tt.target, tt.protocol, tt.dst, err, tt.ok)
+		}
+	}
+}
+
+// Application services and ACL entries accept the same protocols and ports
+func TestValidAppServiceProtoPorts(t *testing.T) {
+	tests := []struct {
+		protocol, src, dst string
+	}{
+		{"ANY", "", ""},
+		{"6", "*", "80"},
+		{"17", "1024-2048", "53"},
+		{"1", "", ""},
+		{"6", "", "80-79"},
+		{"6", "0", ""},
+		{"6", "+80", ""},
+		{"ANY", "", "80"},
+		{"1", "*", ""},
+		{"256", "", ""},
+		{"tcp", "", "80"},
+	}
+
+	for _, tt := range tests {
+		as := ApplicationService{Protocol: tt.protocol, SourcePort: tt.src, DestinationPort: tt.dst}
+		aserr := validappservice(&as)
+		aclerr := validaclentry(100, "FORWARD", tt.protocol, tt.src, tt.dst, "ANY", "", "ANY", "")
+		if (aserr == nil) != (aclerr == nil) {
+			t.Errorf("Protocol [%s] ports %q %q: Application service error: %v, ACL entry error: %v", tt.protocol, tt.src, tt.dst, aserr, aclerr)
+		}
+	}
+}
+
+////////
+//////// VirtualMachine resync
+////////
+
+func TestVMResyncDone(t *testing.T) {
+	const since = 100
+
+	tests := []struct {
+		name string
+		r    VMResync
+		want bool
+	}{
+		{"status from before the request", VMResync{LastRequestTimestamp: 100, LastTimeResyncInitiated: 100, Status: "SUCCESS"}, false},
+		{"status from an older request", VMResync{LastRequestTimestamp: 90, LastTimeResyncInitiated: 95, Status: "FAILED"}, false},
+		{"newer request, not initiated yet", VMResync{LastRequestTimestamp: 200, LastTimeResyncInitiated: 150, Status: "SUCCESS"}, false},
+		{"newer request, in progress", VMResync{LastRequestTimestamp: 200, LastTimeResyncInitiated: 200, Status: "IN_PROGRESS"}, false},
+		{"newer request, empty status", VMResync{LastRequestTimestamp: 200, LastTimeResyncInitiated: 200}, false},
+		{"newer SUCCESS", VMResync{LastRequestTimestamp: 200, LastTimeResyncInitiated: 200, Status: "SUCCESS"}, true},
+		{"newer SUCCESS, initiated later", VMResync{LastRequestTimestamp: 200, LastTimeResyncInitiated: 250, Status: "SUCCESS"}, true},
+		{"newer FAILED", VMResync{LastRequestTimestamp: 200, LastTimeResyncInitiated: 200, Status: "FAILED"}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.Done(since); got != tt.want {
+			t.Errorf("Done(%d), %s: got %t, want %t", since, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestVMWaitResync(t *testing.T) {
+	defer func(interval time.Duration) { VMResyncPollInterval = interval }(VMResyncPollInterval)
+	VMResyncPollInterval = time.Millisecond
+
+	vm := func(ts, initiated int64, status string) string {
+		return fmt.Sprintf(`[{"ID":"vm-1","resyncInfo":{"lastRequestTimestamp":%d,"lastTimeResyncInitiated":%d,"status":"%s"}}]`, ts, initiated, status)
+	}
+	before := vm(100, 100, "SUCCESS")
+
+	tests := []struct {
+		name    string
+		replies []string
+		ok      bool
+		timeout bool
+	}{
+		{"newer SUCCESS", []string{before, vm(200, 200, "IN_PROGRESS"), vm(200, 200, "SUCCESS")}, true, false},
+		{"newer FAILED", []string{before, vm(200, 200, "FAILED")}, false, false},
+		{"stuck in progress", []string{vm(200, 200, "IN_PROGRESS")}, false, true},
+		{"never past the request", []string{before}, false, true},
+	}
+
+	for _, tt := range tests {
+		c, srv := fakeconn(t, map[string][]string{"vms/vm-1": tt.replies})
+
+		polls := 0
+		v := VirtualMachine{ID: "vm-1"}
+		err := v.WaitResync(c, 100, 50*time.Millisecond, func(VMResync) { polls++ })
+		srv.Close()
+
+		if (err == nil) != tt.ok {
+			t.Errorf("WaitResync, %s: got error: %v, want ok: %t", tt.name, err, tt.ok)
+		}
+		if timedout := err != nil && strings.Contains(err.Error(), "Timed out"); timedout != tt.timeout {
+			t.Errorf("WaitResync, %s: got error: %v, want time out: %t", tt.name, err, tt.timeout)
+		}
+		if tt.ok && polls != len(tt.replies) {
+			t.Errorf("WaitResync, %s: got %d polls, want %d", tt.name, polls, len(tt.replies))
+		}
+	}
+
+	if err := (&VirtualMachine{}).WaitResync(nil, 0, time.Second, nil); err == nil {
+		t.Errorf("WaitResync with empty ID should fail")
+	}
+}
+
+////////
+//////// Jobs
+////////
+
+func TestJobWait(t *testing.T) {
+	defer func(interval time.Duration) { JobPollInterval = interval }(JobPollInterval)
+	JobPollInterval = time.Millisecond
+
+	job := func(status string, progress float64) string {
+		return fmt.Sprintf(`[{"ID":"job-1","command":"APPLY_POLICY_CHANGES","status":"%s","progress":%g}]`, status, progress)
+	}
+
+	tests := []struct {
+		name    string
+		replies []string
+		ok      bool
+		timeout bool
+	}{
+		{"SUCCESS", []string{job("RUNNING", 0), job("RUNNING", 0.5), job("SUCCESS", 1)}, true, false},
+		{"FAILED", []string{job("RUNNING", 0), job("FAILED", 0.5)}, false, false},
+		{"stuck running", []string{job("RUNNING", 0.5)}, false, true},
+	}
+
+	for _, tt := range tests {
+		c, srv := fakeconn(t, map[string][]string{"jobs/job-1": tt.replies})
+
+		polls := 0
+		j := Job{ID: "job-1"}
+		err := j.Wait(c, 50*time.Millisecond, func(Job) { polls++ })
+		srv.Close()
+
+		if (err == nil) != tt.ok {
+			t.Errorf("Job Wait, %s: got error: %v, want ok: %t", tt.name, err, tt.ok)
+		}
+		if timedout := err != nil && strings.Contains(err.Error(), "Timed out"); timedout != tt.timeout {
+			t.Errorf("Job Wait, %s: got error: %v, want time out: %t", tt.name, err, tt.timeout)
+		}
+		if !tt.timeout && polls != len(tt.replies) {
+			t.Errorf("Job Wait, %s: got %d polls, want %d", tt.name, polls, len(tt.replies))
+		}
+		if !tt.timeout && j.Status != tt.name {
+			t.Errorf("Job Wait, %s: got final status [%s]", tt.name, j.Status)
+		}
+	}
+
+	if err := (&Job{}).Wait(nil, time.Second, nil); err == nil {
+		t.Errorf("Job Wait with empty ID should fail")
+	}
+}
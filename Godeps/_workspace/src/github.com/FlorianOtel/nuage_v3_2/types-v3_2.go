@@ -1,5 +1,7 @@
 package nuage_v3_2
 
+import "time"
+
 ////////
 //////// VirtualMachine and related
 ////////
@@ -39,6 +41,7 @@ type VirtualMachine struct {
 type VirtualMachineslice []VirtualMachine
 
 // XXX -- TBD -- the REST listing shows "children" as a field as well, but missing from the 3.2 API description (???). Omitting...
+// Status: "IN_PROGRESS" while a resync is running, then "SUCCESS" (or a failure status). Timestamps: Milliseconds since the epoch
 type VMResync struct {
 	LastRequestTimestamp    int64  `json:"lastRequestTimestamp,omitempty"`
 	LastTimeResyncInitiated int64  `json:"lastTimeResyncInitiated,omitempty"`
@@ -91,6 +94,550 @@ type VMInterface struct {
 
 type VMInterfaceslice []VMInterface
 
+////////
+//////// Multi-NIC VPorts: Groups of VPorts sharing an IP address across NICs
+////////
+
+type MultiNICVPort struct {
+	Description     string `json:"description,omitempty"`
+	Name            string `json:"name"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type MultiNICVPortslice []MultiNICVPort
+
+////////
+//////// Application designer: Applications (in Domains), their Tiers and Flows, and Application services
+////////
+
+type Application struct {
+	AssociatedDomainID   string `json:"associatedDomainID,omitempty"`
+	AssociatedDomainType string `json:"associatedDomainType,omitempty"`
+	Description          string `json:"description,omitempty"`
+	Name                 string `json:"name"`
+	CreationDate         int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy        string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate      int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                string `json:"owner,omitempty"`
+	EntityScope          string `json:"entityScope,omitempty"`
+	ExternalID           string `json:"externalID,omitempty"`
+	ID                   string `json:"ID,omitempty"`
+	ParentID             string `json:"parentID"`
+	ParentType           string `json:"parentType,omitempty"`
+}
+
+type Applicationslice []Application
+
+// Type: "STANDARD" (bound to Zones / Subnets) or "NETWORK_MACRO" (an external network, see AssociatedNetworkMacroID)
+type Tier struct {
+	Address                  string `json:"address,omitempty"`
+	AssociatedApplicationID  string `json:"associatedApplicationID,omitempty"`
+	AssociatedNetworkMacroID string `json:"associatedNetworkMacroID,omitempty"`
+	Description              string `json:"description,omitempty"`
+	Gateway                  string `json:"gateway,omitempty"`
+	Name                     string `json:"name"`
+	Netmask                  string `json:"netmask,omitempty"`
+	Type                     string `json:"type,omitempty"`
+	CreationDate             int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy            string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate          int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                    string `json:"owner,omitempty"`
+	EntityScope              string `json:"entityScope,omitempty"`
+	ExternalID               string `json:"externalID,omitempty"`
+	ID                       string `json:"ID,omitempty"`
+	ParentID                 string `json:"parentID"`
+	ParentType               string `json:"parentType,omitempty"`
+}
+
+type Tierslice []Tier
+
+// Traffic allowed from one Tier to another, optionally restricted to an Application service
+type Flow struct {
+	AssociatedApplicationServiceID string `json:"associatedApplicationServiceID,omitempty"`
+	Description                    string `json:"description,omitempty"`
+	DestinationTierID              string `json:"destinationTierID"`
+	Name                           string `json:"name"`
+	OriginTierID                   string `json:"originTierID"`
+	CreationDate                   int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                  string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                          string `json:"owner,omitempty"`
+	EntityScope                    string `json:"entityScope,omitempty"`
+	ExternalID                     string `json:"externalID,omitempty"`
+	ID                             string `json:"ID,omitempty"`
+	ParentID                       string `json:"parentID"`
+	ParentType                     string `json:"parentType,omitempty"`
+}
+
+type Flowslice []Flow
+
+// A service (protocol and ports) used by Flows. Protocol: "ANY" or an IANA protocol number. Ports only for TCP / UDP
+type ApplicationService struct {
+	Description     string `json:"description,omitempty"`
+	DestinationPort string `json:"destinationPort,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	DSCP            string `json:"DSCP,omitempty"`
+	EtherType       string `json:"etherType,omitempty"`
+	Name            string `json:"name"`
+	Protocol        string `json:"protocol"`
+	SourcePort      string `json:"sourcePort,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type ApplicationServiceslice []ApplicationService
+
+////////
+//////// Alarms and Event logs (Enterprises, Domains, L2 Domains, VRSs, VPorts)
+////////
+
+// Severity: One of AlarmSeverities. Timestamp: Milliseconds since the epoch
+type Alarm struct {
+	Acknowledged       bool   `json:"acknowledged"`
+	Description        string `json:"description,omitempty"`
+	EnterpriseID       string `json:"enterpriseID,omitempty"`
+	ErrorCondition     int    `json:"errorCondition,omitempty"`
+	Name               string `json:"name,omitempty"`
+	NumberOfOccurances int    `json:"numberOfOccurances,omitempty"`
+	Reason             string `json:"reason,omitempty"`
+	Remedy             string `json:"remedy,omitempty"`
+	Severity           string `json:"severity,omitempty"`
+	TargetObject       string `json:"targetObject,omitempty"`
+	Timestamp          int64  `json:"timestamp,omitempty"`
+	Title              string `json:"title,omitempty"`
+	CreationDate       int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy      string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate    int64  `json:"lastUpdatedDate,omitempty"`
+	Owner              string `json:"owner,omitempty"`
+	EntityScope        string `json:"entityScope,omitempty"`
+	ExternalID         string `json:"externalID,omitempty"`
+	ID                 string `json:"ID,omitempty"`
+	ParentID           string `json:"parentID"`
+	ParentType         string `json:"parentType,omitempty"`
+}
+
+type Alarmslice []Alarm
+
+// Alarm severities, most severe first
+var AlarmSeverities = []string{"CRITICAL", "MAJOR", "MINOR", "WARNING", "INFO"}
+
+// An audit record of a change to an entity. Type: e.g. "CREATE", "UPDATE", "DELETE". EventReceivedTime: Milliseconds since the epoch
+type EventLog struct {
+	Diff              interface{} `json:"diff,omitempty"`
+	EnterpriseID      string      `json:"enterpriseID,omitempty"`
+	EnterpriseName    string      `json:"enterpriseName,omitempty"`
+	EntityID          string      `json:"entityID,omitempty"`
+	EntityParentID    string      `json:"entityParentID,omitempty"`
+	EntityParentType  string      `json:"entityParentType,omitempty"`
+	EntityType        string      `json:"entityType,omitempty"`
+	EventReceivedTime int64       `json:"eventReceivedTime,omitempty"`
+	RequestID         string      `json:"requestID,omitempty"`
+	Type              string      `json:"type,omitempty"`
+	User              string      `json:"user,omitempty"`
+	CreationDate      int64       `json:"creationDate,omitempty"`
+	LastUpdatedBy     string      `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate   int64       `json:"lastUpdatedDate,omitempty"`
+	Owner             string      `json:"owner,omitempty"`
+	EntityScope       string      `json:"entityScope,omitempty"`
+	ExternalID        string      `json:"externalID,omitempty"`
+	ID                string      `json:"ID,omitempty"`
+	ParentID          string      `json:"parentID"`
+	ParentType        string      `json:"parentType,omitempty"`
+}
+
+type EventLogslice []EventLog
+
+////////
+//////// Multicast: Channel maps, their address ranges, and Enterprise multicast lists (send / receive)
+////////
+
+type MulticastChannelMap struct {
+	Description     string `json:"description,omitempty"`
+	Name            string `json:"name"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID,omitempty"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type MulticastChannelMapslice []MulticastChannelMap
+
+// A range of multicast group addresses, part of a Channel map
+type MulticastRange struct {
+	IPType          string `json:"IPType,omitempty"`
+	MaxAddress      string `json:"maxAddress"`
+	MinAddress      string `json:"minAddress"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type MulticastRangeslice []MulticastRange
+
+// A list of Channel maps, referred to by Enterprise.SendMultiCastListID / ReceiveMultiCastListID. Unlimited: All channels allowed
+type MulticastList struct {
+	McastType       string `json:"mcastType,omitempty"`
+	Unlimited       bool   `json:"unlimited"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type MulticastListslice []MulticastList
+
+////////
+//////// QoS (Domains, Zones, Subnets, VPorts, L2 Domains) and Rate limiters (Enterprises)
+////////
+
+// Rates (Peak, CommittedInformationRate, FIP...) are in Mbps, as decimal strings, or "INFINITY". Burst sizes are in KB, as integer strings.
+// Use "ParseRate" / "ParseBurst" to convert from human readable values, e.g. "1.5Gbps", "512KB"
+type QoS struct {
+	Active                      bool   `json:"active"`
+	BucketUpdateInterval        int    `json:"bucketUpdateInterval,omitempty"`
+	Burst                       string `json:"burst,omitempty"`
+	CommittedBurstSize          string `json:"committedBurstSize,omitempty"`
+	CommittedInformationRate    string `json:"committedInformationRate,omitempty"`
+	Description                 string `json:"description,omitempty"`
+	FIPCommittedBurstSize       string `json:"FIPCommittedBurstSize,omitempty"`
+	FIPCommittedInformationRate string `json:"FIPCommittedInformationRate,omitempty"`
+	FIPPeakBurstSize            string `json:"FIPPeakBurstSize,omitempty"`
+	FIPPeakInformationRate      string `json:"FIPPeakInformationRate,omitempty"`
+	FIPRateLimitingActive       bool   `json:"FIPRateLimitingActive"`
+	Name                        string `json:"name"`
+	Peak                        string `json:"peak,omitempty"`
+	RateLimitingActive          bool   `json:"rateLimitingActive"`
+	RewriteForwardingClass      bool   `json:"rewriteForwardingClass"`
+	ServiceClass                string `json:"serviceClass,omitempty"`
+	TemplateID                  string `json:"templateID,omitempty"`
+	CreationDate                int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy               string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate             int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                       string `json:"owner,omitempty"`
+	EntityScope                 string `json:"entityScope,omitempty"`
+	ExternalID                  string `json:"externalID,omitempty"`
+	ID                          string `json:"ID,omitempty"`
+	ParentID                    string `json:"parentID"`
+	ParentType                  string `json:"parentType,omitempty"`
+}
+
+type QoSslice []QoS
+
+// Reusable rate / burst profile, defined at the Enterprise level. Same units as for QoS
+type RateLimiter struct {
+	CommittedInformationRate string `json:"committedInformationRate,omitempty"`
+	Description              string `json:"description,omitempty"`
+	Name                     string `json:"name"`
+	PeakBurstSize            string `json:"peakBurstSize"`
+	PeakInformationRate      string `json:"peakInformationRate"`
+	CreationDate             int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy            string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate          int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                    string `json:"owner,omitempty"`
+	EntityScope              string `json:"entityScope,omitempty"`
+	ExternalID               string `json:"externalID,omitempty"`
+	ID                       string `json:"ID,omitempty"`
+	ParentID                 string `json:"parentID"`
+	ParentType               string `json:"parentType,omitempty"`
+}
+
+type RateLimiterslice []RateLimiter
+
+////////
+//////// Metadata -- child objects of (almost) any entity. Used as key / value tags: Name is the key, Blob the value
+////////
+
+// Global: Global metadata, visible across Enterprises ("globalmetadatas") instead of "metadatas"
+type Metadata struct {
+	Blob                        string   `json:"blob"`
+	Description                 string   `json:"description,omitempty"`
+	Global                      bool     `json:"global,omitempty"`
+	MetadataTagIDs              []string `json:"metadataTagIDs,omitempty"`
+	Name                        string   `json:"name"`
+	NetworkNotificationDisabled bool     `json:"networkNotificationDisabled,omitempty"`
+	CreationDate                int64    `json:"creationDate,omitempty"`
+	LastUpdatedBy               string   `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate             int64    `json:"lastUpdatedDate,omitempty"`
+	Owner                       string   `json:"owner,omitempty"`
+	EntityScope                 string   `json:"entityScope,omitempty"`
+	ExternalID                  string   `json:"externalID,omitempty"`
+	ID                          string   `json:"ID,omitempty"`
+	ParentID                    string   `json:"parentID"`
+	ParentType                  string   `json:"parentType,omitempty"`
+}
+
+type Metadataslice []Metadata
+
+// An entity found by its tags: Its type, ID, name (if any) and metadata
+type TaggedEntity struct {
+	Type     string
+	ID       string
+	Name     string
+	Metadata []Metadata
+}
+
+////////
+//////// Statistics (VPorts, Subnets, Zones, Domains)
+////////
+
+// Stats: One series of NumberOfDataPoints values per metric, e.g. "BYTES_IN". StartTime / EndTime: In seconds since the epoch
+type Statistics struct {
+	EndTime            int64                `json:"endTime,omitempty"`
+	NumberOfDataPoints int                  `json:"numberOfDataPoints,omitempty"`
+	StartTime          int64                `json:"startTime,omitempty"`
+	Stats              map[string][]float64 `json:"stats,omitempty"`
+	Version            int                  `json:"version,omitempty"`
+	ID                 string               `json:"ID,omitempty"`
+	ParentID           string               `json:"parentID,omitempty"`
+	ParentType         string               `json:"parentType,omitempty"`
+}
+
+// Statistics query: Metrics (all the default ones if empty), time range [Start, End) and Granularity (the interval between data points)
+type StatisticsQuery struct {
+	Metrics     []string
+	Start       time.Time
+	End         time.Time
+	Granularity time.Duration
+}
+
+// Metrics queried by default
+var StatisticsMetrics = []string{
+	"BYTES_IN",
+	"BYTES_OUT",
+	"PACKETS_IN",
+	"PACKETS_OUT",
+	"PACKETS_IN_DROPPED",
+	"PACKETS_OUT_DROPPED",
+	"PACKETS_IN_ERROR",
+	"PACKETS_OUT_ERROR",
+}
+
+////////
+//////// Jobs -- asynchronous VSD operations (e.g. template instantiation, export / import, VM resync, policy changes)
+////////
+
+// Status: "RUNNING", "SUCCESS" or "FAILED". Progress: Between 0 and 1
+type Job struct {
+	Command         string      `json:"command"`
+	Parameters      interface{} `json:"parameters,omitempty"`
+	Progress        float64     `json:"progress,omitempty"`
+	Result          interface{} `json:"result,omitempty"`
+	Status          string      `json:"status,omitempty"`
+	CreationDate    int64       `json:"creationDate,omitempty"`
+	LastUpdatedBy   string      `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64       `json:"lastUpdatedDate,omitempty"`
+	Owner           string      `json:"owner,omitempty"`
+	EntityScope     string      `json:"entityScope,omitempty"`
+	ExternalID      string      `json:"externalID,omitempty"`
+	ID              string      `json:"ID,omitempty"`
+	ParentID        string      `json:"parentID"`
+	ParentType      string      `json:"parentType,omitempty"`
+}
+
+type Jobslice []Job
+
+////////
+//////// Redirection targets (service chaining) and Virtual IPs (VRRP / HA pairs)
+////////
+
+// EndPointType: "L3" (default) or "VIRTUAL_WIRE". TriggerType: "NONE" (default) or "GARP"
+type RedirectionTarget struct {
+	ESI               string `json:"ESI,omitempty"`
+	Description       string `json:"description,omitempty"`
+	EndPointType      string `json:"endPointType,omitempty"`
+	Name              string `json:"name"`
+	RedundancyEnabled bool   `json:"redundancyEnabled,omitempty"`
+	TemplateID        string `json:"templateID,omitempty"`
+	TriggerType       string `json:"triggerType,omitempty"`
+	VirtualNetworkID  string `json:"virtualNetworkID,omitempty"`
+	CreationDate      int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy     string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate   int64  `json:"lastUpdatedDate,omitempty"`
+	Owner             string `json:"owner,omitempty"`
+	EntityScope       string `json:"entityScope,omitempty"`
+	ExternalID        string `json:"externalID,omitempty"`
+	ID                string `json:"ID,omitempty"`
+	ParentID          string `json:"parentID"`
+	ParentType        string `json:"parentType,omitempty"`
+}
+
+type RedirectionTargetslice []RedirectionTarget
+
+type VirtualIP struct {
+	MAC                    string `json:"MAC,omitempty"`
+	AssociatedFloatingIPID string `json:"associatedFloatingIPID,omitempty"`
+	SubnetID               string `json:"subnetID,omitempty"`
+	VirtualIP              string `json:"virtualIP"`
+	CreationDate           int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy          string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate        int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                  string `json:"owner,omitempty"`
+	EntityScope            string `json:"entityScope,omitempty"`
+	ExternalID             string `json:"externalID,omitempty"`
+	ID                     string `json:"ID,omitempty"`
+	ParentID               string `json:"parentID"`
+	ParentType             string `json:"parentType,omitempty"`
+}
+
+type VirtualIPslice []VirtualIP
+
+////////
+//////// Ingress advanced forwarding (forwarding policies): Templates and their entries
+////////
+
+// ParentType is one of "domain", "domaintemplate", "l2domain", "l2domaintemplate"
+type IngressAdvFwdTemplate struct {
+	Active                 bool   `json:"active,omitempty"`
+	AssociatedLiveEntityID string `json:"associatedLiveEntityID,omitempty"`
+	Description            string `json:"description,omitempty"`
+	Name                   string `json:"name"`
+	PolicyState            string `json:"policyState,omitempty"`
+	Priority               int    `json:"priority,omitempty"`
+	PriorityType           string `json:"priorityType,omitempty"`
+	CreationDate           int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy          string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate        int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                  string `json:"owner,omitempty"`
+	EntityScope            string `json:"entityScope,omitempty"`
+	ExternalID             string `json:"externalID,omitempty"`
+	ID                     string `json:"ID,omitempty"`
+	ParentID               string `json:"parentID"`
+	ParentType             string `json:"parentType,omitempty"`
+}
+
+type IngressAdvFwdTemplateslice []IngressAdvFwdTemplate
+
+// Action: "FORWARD", "DROP" or "REDIRECT". With "REDIRECT", RedirectVPortTagID is the ID of the Redirection target the traffic is sent to
+type IngressAdvFwdEntry struct {
+	Action                 string `json:"action"`
+	AddressOverride        string `json:"addressOverride,omitempty"`
+	AssociatedLiveEntityID string `json:"associatedLiveEntityID,omitempty"`
+	DSCP                   string `json:"DSCP,omitempty"`
+	Description            string `json:"description,omitempty"`
+	DestinationPort        string `json:"destinationPort,omitempty"`
+	EtherType              string `json:"etherType"`
+	FlowLoggingEnabled     bool   `json:"flowLoggingEnabled,omitempty"`
+	LocationID             string `json:"locationID,omitempty"`
+	LocationType           string `json:"locationType"`
+	NetworkID              string `json:"networkID,omitempty"`
+	NetworkType            string `json:"networkType"`
+	PolicyState            string `json:"policyState,omitempty"`
+	Priority               int    `json:"priority"`
+	Protocol               string `json:"protocol"`
+	RedirectRewriteType    string `json:"redirectRewriteType,omitempty"`
+	RedirectRewriteValue   string `json:"redirectRewriteValue,omitempty"`
+	RedirectVPortTagID     string `json:"redirectVPortTagID,omitempty"`
+	SourcePort             string `json:"sourcePort,omitempty"`
+	StatsID                string `json:"statsID,omitempty"`
+	StatsLoggingEnabled    bool   `json:"statsLoggingEnabled,omitempty"`
+	UplinkPreference       string `json:"uplinkPreference,omitempty"`
+	CreationDate           int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy          string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate        int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                  string `json:"owner,omitempty"`
+	EntityScope            string `json:"entityScope,omitempty"`
+	ExternalID             string `json:"externalID,omitempty"`
+	ID                     string `json:"ID,omitempty"`
+	ParentID               string `json:"parentID"`
+	ParentType             string `json:"parentType,omitempty"`
+}
+
+type IngressAdvFwdEntryslice []IngressAdvFwdEntry
+
+////////
+//////// Host and Bridge interfaces -- the counterpart of VMInterface for HOST and BRIDGE VPorts
+////////
+
+type HostInterface struct {
+	IPAddress                   string `json:"IPAddress,omitempty"`
+	MAC                         string `json:"MAC"`
+	Name                        string `json:"name"`
+	AssociatedFloatingIPAddress string `json:"associatedFloatingIPAddress,omitempty"`
+	AttachedNetworkID           string `json:"attachedNetworkID,omitempty"`
+	AttachedNetworkType         string `json:"attachedNetworkType,omitempty"`
+	DomainID                    string `json:"domainID,omitempty"`
+	DomainName                  string `json:"domainName,omitempty"`
+	Gateway                     string `json:"gateway,omitempty"`
+	Netmask                     string `json:"netmask,omitempty"`
+	NetworkName                 string `json:"networkName,omitempty"`
+	PolicyDecisionID            string `json:"policyDecisionID,omitempty"`
+	TierID                      string `json:"tierID,omitempty"`
+	VPortID                     string `json:"VPortID,omitempty"`
+	VPortName                   string `json:"VPortName,omitempty"`
+	ZoneID                      string `json:"zoneID,omitempty"`
+	ZoneName                    string `json:"zoneName,omitempty"`
+	CreationDate                int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy               string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate             int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                       string `json:"owner,omitempty"`
+	EntityScope                 string `json:"entityScope,omitempty"`
+	ExternalID                  string `json:"externalID,omitempty"`
+	ID                          string `json:"ID,omitempty"`
+	ParentID                    string `json:"parentID"`
+	ParentType                  string `json:"parentType,omitempty"`
+}
+
+type HostInterfaceslice []HostInterface
+
+type BridgeInterface struct {
+	Name                string `json:"name"`
+	AttachedNetworkID   string `json:"attachedNetworkID,omitempty"`
+	AttachedNetworkType string `json:"attachedNetworkType,omitempty"`
+	DomainID            string `json:"domainID,omitempty"`
+	DomainName          string `json:"domainName,omitempty"`
+	Gateway             string `json:"gateway,omitempty"`
+	Netmask             string `json:"netmask,omitempty"`
+	NetworkName         string `json:"networkName,omitempty"`
+	PolicyDecisionID    string `json:"policyDecisionID,omitempty"`
+	TierID              string `json:"tierID,omitempty"`
+	VPortID             string `json:"VPortID,omitempty"`
+	VPortName           string `json:"VPortName,omitempty"`
+	ZoneID              string `json:"zoneID,omitempty"`
+	ZoneName            string `json:"zoneName,omitempty"`
+	CreationDate        int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy       string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate     int64  `json:"lastUpdatedDate,omitempty"`
+	Owner               string `json:"owner,omitempty"`
+	EntityScope         string `json:"entityScope,omitempty"`
+	ExternalID          string `json:"externalID,omitempty"`
+	ID                  string `json:"ID,omitempty"`
+	ParentID            string `json:"parentID"`
+	ParentType          string `json:"parentType,omitempty"`
+}
+
+type BridgeInterfaceslice []BridgeInterface
+
 ////////
 //////// vPort
 ////////
@@ -169,6 +716,35 @@ type Subnet struct {
 
 type Subnetslice []Subnet
 
+////////
+//////// Subnet template
+////////
+
+type Subnettemplate struct {
+	Address                         string `json:"address"`
+	AssociatedMulticastChannelMapID string `json:"associatedMulticastChannelMapID,omitempty"`
+	Description                     string `json:"description,omitempty"`
+	Encryption                      string `json:"encryption,omitempty"`
+	Gateway                         string `json:"gateway,omitempty"`
+	IPType                          string `json:"IPType,omitempty"`
+	Multicast                       string `json:"multicast,omitempty"`
+	Name                            string `json:"name"`
+	Netmask                         string `json:"netmask"`
+	ProxyARP                        bool   `json:"proxyARP,omitempty"`
+	SplitSubnet                     bool   `json:"splitSubnet,omitempty"`
+	CreationDate                    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                 int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                           string `json:"owner,omitempty"`
+	EntityScope                     string `json:"entityScope,omitempty"`
+	ExternalID                      string `json:"externalID,omitempty"`
+	ID                              string `json:"ID,omitempty"`
+	ParentID                        string `json:"parentID"`
+	ParentType                      string `json:"parentType,omitempty"`
+}
+
+type Subnettemplateslice []Subnettemplate
+
 ////////
 //////// Zone
 ////////
@@ -304,6 +880,633 @@ type Domaintemplate struct {
 
 type Domaintemplateslice []Domaintemplate
 
+////////
+//////// L2 Domain
+////////
+
+type L2Domain struct {
+	Address                           string `json:"address,omitempty"`
+	AssociatedMulticastChannelMapID   string `json:"associatedMulticastChannelMapID,omitempty"`
+	AssociatedSharedNetworkResourceID string `json:"associatedSharedNetworkResourceID,omitempty"`
+	TemplateID                        string `json:"templateID,omitempty"`
+	Description                       string `json:"description,omitempty"`
+	DHCPManaged                       bool   `json:"DHCPManaged,omitempty"`
+	Encryption                        string `json:"encryption,omitempty"`
+	Gateway                           string `json:"gateway,omitempty"`
+	GatewayMACAddress                 string `json:"gatewayMACAddress,omitempty"`
+	IPType                            string `json:"IPType,omitempty"`
+	MaintenanceMode                   string `json:"maintenanceMode,omitempty"`
+	Multicast                         string `json:"multicast,omitempty"`
+	Name                              string `json:"name"`
+	Netmask                           string `json:"netmask,omitempty"`
+	PolicyChangeStatus                string `json:"policyChangeStatus,omitempty"`
+	RouteDistinguisher                string `json:"routeDistinguisher,omitempty"`
+	RouteTarget                       string `json:"routeTarget,omitempty"`
+	ServiceID                         int32  `json:"serviceID,omitempty"`
+	Stretched                         bool   `json:"stretched,omitempty"`
+	VnId                              int32  `json:"vnId,omitempty"`
+	CreationDate                      int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                     string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                   int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                             string `json:"owner,omitempty"`
+	EntityScope                       string `json:"entityScope,omitempty"`
+	ExternalID                        string `json:"externalID,omitempty"`
+	ID                                string `json:"ID,omitempty"`
+	ParentID                          string `json:"parentID"`
+	ParentType                        string `json:"parentType,omitempty"`
+}
+
+type L2Domainslice []L2Domain
+
+////////
+//////// L2 Domain template
+////////
+
+// DHCPManaged L2 domain templates must have an Address and Netmask (and optionally a Gateway). Unmanaged ones have none.
+type L2Domaintemplate struct {
+	Address                         string `json:"address,omitempty"`
+	AssociatedMulticastChannelMapID string `json:"associatedMulticastChannelMapID,omitempty"`
+	Description                     string `json:"description,omitempty"`
+	DHCPManaged                     bool   `json:"DHCPManaged,omitempty"`
+	Encryption                      string `json:"encryption,omitempty"`
+	Gateway                         string `json:"gateway,omitempty"`
+	IPType                          string `json:"IPType,omitempty"`
+	Multicast                       string `json:"multicast,omitempty"`
+	Name                            string `json:"name"`
+	Netmask                         string `json:"netmask,omitempty"`
+	PolicyChangeStatus              string `json:"policyChangeStatus,omitempty"`
+	CreationDate                    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                 int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                           string `json:"owner,omitempty"`
+	EntityScope                     string `json:"entityScope,omitempty"`
+	ExternalID                      string `json:"externalID,omitempty"`
+	ID                              string `json:"ID,omitempty"`
+	ParentID                        string `json:"parentID"`
+	ParentType                      string `json:"parentType,omitempty"`
+}
+
+type L2Domaintemplateslice []L2Domaintemplate
+
+////////
+//////// Infrastructure -- read only: VSPs (VSD clusters), VSCs and VRSs
+////////
+
+// Status: E.g. "UP", "DOWN", "ADMIN_DOWN"
+type VSP struct {
+	Description     string `json:"description,omitempty"`
+	Location        string `json:"location,omitempty"`
+	Name            string `json:"name"`
+	ProductVersion  string `json:"productVersion,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID,omitempty"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type VSPslice []VSP
+
+// Uptime: In milliseconds
+type VSC struct {
+	Address         string  `json:"address,omitempty"`
+	AverageCPUUsage float64 `json:"averageCPUUsage,omitempty"`
+	AverageMemory   float64 `json:"averageMemoryUsage,omitempty"`
+	Description     string  `json:"description,omitempty"`
+	Location        string  `json:"location,omitempty"`
+	ManagementIP    string  `json:"managementIP,omitempty"`
+	Name            string  `json:"name"`
+	ProductVersion  string  `json:"productVersion,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	Uptime          int64   `json:"uptime,omitempty"`
+	CreationDate    int64   `json:"creationDate,omitempty"`
+	LastUpdatedBy   string  `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64   `json:"lastUpdatedDate,omitempty"`
+	Owner           string  `json:"owner,omitempty"`
+	EntityScope     string  `json:"entityScope,omitempty"`
+	ExternalID      string  `json:"externalID,omitempty"`
+	ID              string  `json:"ID,omitempty"`
+	ParentID        string  `json:"parentID,omitempty"`
+	ParentType      string  `json:"parentType,omitempty"`
+}
+
+type VSCslice []VSC
+
+// Personality: E.g. "VRS", "VRSG", "HARDWARE_VTEP". Uptime: In milliseconds
+type VRS struct {
+	Address                  string  `json:"address,omitempty"`
+	AverageCPUUsage          float64 `json:"averageCPUUsage,omitempty"`
+	AverageMemory            float64 `json:"averageMemoryUsage,omitempty"`
+	Description              string  `json:"description,omitempty"`
+	HypervisorIdentifier     string  `json:"hypervisorIdentifier,omitempty"`
+	HypervisorName           string  `json:"hypervisorName,omitempty"`
+	HypervisorType           string  `json:"hypervisorType,omitempty"`
+	JSONRPCConnectionState   string  `json:"JSONRPCConnectionState,omitempty"`
+	ManagementIP             string  `json:"managementIP,omitempty"`
+	Name                     string  `json:"name"`
+	NumberOfBridgeInterfaces int     `json:"numberOfBridgeInterfaces,omitempty"`
+	NumberOfHostInterfaces   int     `json:"numberOfHostInterfaces,omitempty"`
+	NumberOfVirtualMachines  int     `json:"numberOfVirtualMachines,omitempty"`
+	Personality              string  `json:"personality,omitempty"`
+	ProductVersion           string  `json:"productVersion,omitempty"`
+	Role                     string  `json:"role,omitempty"`
+	Status                   string  `json:"status,omitempty"`
+	Uptime                   int64   `json:"uptime,omitempty"`
+	CreationDate             int64   `json:"creationDate,omitempty"`
+	LastUpdatedBy            string  `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate          int64   `json:"lastUpdatedDate,omitempty"`
+	Owner                    string  `json:"owner,omitempty"`
+	EntityScope              string  `json:"entityScope,omitempty"`
+	ExternalID               string  `json:"externalID,omitempty"`
+	ID                       string  `json:"ID,omitempty"`
+	ParentID                 string  `json:"parentID,omitempty"`
+	ParentType               string  `json:"parentType,omitempty"`
+}
+
+type VRSslice []VRS
+
+////////
+//////// Gateways (VRS-G / VSG), gateway ports and VLANs
+////////
+
+// Personality: E.g. "VRSG", "VSG", "VSA", "HARDWARE_VTEP"
+type Gateway struct {
+	AutoDiscGatewayID  string `json:"autoDiscGatewayID,omitempty"`
+	Description        string `json:"description,omitempty"`
+	EnterpriseID       string `json:"enterpriseID,omitempty"`
+	Name               string `json:"name"`
+	Pending            bool   `json:"pending,omitempty"`
+	Personality        string `json:"personality,omitempty"`
+	RedundancyGroupID  string `json:"redundancyGroupID,omitempty"`
+	SystemID           string `json:"systemID,omitempty"`
+	TemplateID         string `json:"templateID,omitempty"`
+	UseGatewayVLANVNID bool   `json:"useGatewayVLANVNID,omitempty"`
+	Vtep               string `json:"vtep,omitempty"`
+	CreationDate       int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy      string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate    int64  `json:"lastUpdatedDate,omitempty"`
+	Owner              string `json:"owner,omitempty"`
+	EntityScope        string `json:"entityScope,omitempty"`
+	ExternalID         string `json:"externalID,omitempty"`
+	ID                 string `json:"ID,omitempty"`
+	ParentID           string `json:"parentID,omitempty"`
+	ParentType         string `json:"parentType,omitempty"`
+}
+
+type Gatewayslice []Gateway
+
+// PortType: "ACCESS" or "NETWORK". VLANRange: E.g. "0-4094"
+type Port struct {
+	VLANRange       string `json:"VLANRange,omitempty"`
+	Description     string `json:"description,omitempty"`
+	IsResilient     bool   `json:"isResilient,omitempty"`
+	Name            string `json:"name"`
+	PhysicalName    string `json:"physicalName,omitempty"`
+	PortType        string `json:"portType,omitempty"`
+	Status          string `json:"status,omitempty"`
+	TemplateID      string `json:"templateID,omitempty"`
+	UseUserMnemonic bool   `json:"useUserMnemonic,omitempty"`
+	UserMnemonic    string `json:"userMnemonic,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID,omitempty"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type Portslice []Port
+
+// Value: The VLAN number (0-4094). VPortID: The (BRIDGE or HOST) VPort bound to this VLAN, if any
+type VLAN struct {
+	Description     string `json:"description,omitempty"`
+	GatewayID       string `json:"gatewayID,omitempty"`
+	Status          string `json:"status,omitempty"`
+	TemplateID      string `json:"templateID,omitempty"`
+	UseUserMnemonic bool   `json:"useUserMnemonic,omitempty"`
+	UserMnemonic    string `json:"userMnemonic,omitempty"`
+	Value           int    `json:"value"`
+	VPortID         string `json:"vportID,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type VLANslice []VLAN
+
+////////
+//////// DHCP options (Domains, Zones, Subnets, VPorts, L2 Domains)
+////////
+
+// Type, Length and Value are hex encoded, as per RFC 2132. Use "Encode" / "Decode" instead of hand-crafting them
+type DHCPOption struct {
+	ActualType      int      `json:"actualType,omitempty"`
+	ActualValues    []string `json:"actualValues,omitempty"`
+	Length          string   `json:"length"`
+	Type            string   `json:"type"`
+	Value           string   `json:"value"`
+	CreationDate    int64    `json:"creationDate,omitempty"`
+	LastUpdatedBy   string   `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64    `json:"lastUpdatedDate,omitempty"`
+	Owner           string   `json:"owner,omitempty"`
+	EntityScope     string   `json:"entityScope,omitempty"`
+	ExternalID      string   `json:"externalID,omitempty"`
+	ID              string   `json:"ID,omitempty"`
+	ParentID        string   `json:"parentID"`
+	ParentType      string   `json:"parentType,omitempty"`
+}
+
+type DHCPOptionslice []DHCPOption
+
+// Common DHCP option codes, by name. Options not listed here can still be used by code, with a hex encoded value
+var DHCPOptionCodes = map[string]int{
+	"netmask":    1,
+	"router":     3,
+	"dns":        6,
+	"hostname":   12,
+	"domainname": 15,
+	"mtu":        26,
+	"broadcast":  28,
+	"ntp":        42,
+	"netbiosns":  44,
+	"leasetime":  51,
+	"tftpserver": 66,
+	"bootfile":   67,
+	"routes":     121,
+}
+
+////////
+//////// Enterprise users and groups
+////////
+
+// Password: SHA1 hash of the password, hex encoded -- as expected by the VSD. Use "SetPassword" to set it from clear text
+type User struct {
+	AvatarData      string `json:"avatarData,omitempty"`
+	AvatarType      string `json:"avatarType,omitempty"`
+	Disabled        bool   `json:"disabled,omitempty"`
+	Email           string `json:"email"`
+	FirstName       string `json:"firstName"`
+	LastName        string `json:"lastName"`
+	MobileNumber    string `json:"mobileNumber,omitempty"`
+	Password        string `json:"password,omitempty"`
+	UserName        string `json:"userName"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type Userslice []User
+
+// Role: E.g. "USER" (default), "ORGADMIN", "ORGNETWORKDESIGNER"
+type Group struct {
+	AccountRestrictions bool   `json:"accountRestrictions,omitempty"`
+	Description         string `json:"description,omitempty"`
+	Name                string `json:"name"`
+	Private             bool   `json:"private,omitempty"`
+	RestrictionDate     int64  `json:"restrictionDate,omitempty"`
+	Role                string `json:"role,omitempty"`
+	CreationDate        int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy       string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate     int64  `json:"lastUpdatedDate,omitempty"`
+	Owner               string `json:"owner,omitempty"`
+	EntityScope         string `json:"entityScope,omitempty"`
+	ExternalID          string `json:"externalID,omitempty"`
+	ID                  string `json:"ID,omitempty"`
+	ParentID            string `json:"parentID"`
+	ParentType          string `json:"parentType,omitempty"`
+}
+
+type Groupslice []Group
+
+////////
+//////// Enterprise network macros (EnterpriseNetwork) and Network macro groups
+////////
+
+// IPType: "IPV4" (default)
+type EnterpriseNetwork struct {
+	IPType          string `json:"IPType,omitempty"`
+	Address         string `json:"address"`
+	Description     string `json:"description,omitempty"`
+	Name            string `json:"name"`
+	Netmask         string `json:"netmask"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type EnterpriseNetworkslice []EnterpriseNetwork
+
+type NetworkMacroGroup struct {
+	Description     string `json:"description,omitempty"`
+	Name            string `json:"name"`
+	NetworkMacros   string `json:"networkMacros,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type NetworkMacroGroupslice []NetworkMacroGroup
+
+////////
+//////// Static routes (L3 Domains and L2 Domains)
+////////
+
+// Type: "OVERLAY" (default) or "EXIT_DOMAIN"
+type StaticRoute struct {
+	IPType             string `json:"IPType,omitempty"`
+	Address            string `json:"address"`
+	AssociatedSubnetID string `json:"associatedSubnetID,omitempty"`
+	Netmask            string `json:"netmask"`
+	NextHopIp          string `json:"nextHopIp"`
+	RouteDistinguisher string `json:"routeDistinguisher,omitempty"`
+	Type               string `json:"type,omitempty"`
+	CreationDate       int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy      string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate    int64  `json:"lastUpdatedDate,omitempty"`
+	Owner              string `json:"owner,omitempty"`
+	EntityScope        string `json:"entityScope,omitempty"`
+	ExternalID         string `json:"externalID,omitempty"`
+	ID                 string `json:"ID,omitempty"`
+	ParentID           string `json:"parentID,omitempty"`
+	ParentType         string `json:"parentType,omitempty"`
+}
+
+type StaticRouteslice []StaticRoute
+
+////////
+//////// Shared network resources (e.g. FIP pools) and Floating IPs
+////////
+
+// Type: "FLOATING" (FIP pool), "PUBLIC", "L2DOMAIN", "UPLINK_SUBNET"
+type SharedNetworkResource struct {
+	AccessRestrictionEnabled   bool   `json:"accessRestrictionEnabled,omitempty"`
+	Address                    string `json:"address,omitempty"`
+	BackHaulRouteDistinguisher string `json:"backHaulRouteDistinguisher,omitempty"`
+	BackHaulRouteTarget        string `json:"backHaulRouteTarget,omitempty"`
+	BackHaulVNID               int32  `json:"backHaulVNID,omitempty"`
+	Description                string `json:"description,omitempty"`
+	DHCPManaged                bool   `json:"DHCPManaged,omitempty"`
+	DomainRouteDistinguisher   string `json:"domainRouteDistinguisher,omitempty"`
+	DomainRouteTarget          string `json:"domainRouteTarget,omitempty"`
+	ECMPCount                  int    `json:"ECMPCount,omitempty"`
+	Gateway                    string `json:"gateway,omitempty"`
+	GatewayMACAddress          string `json:"gatewayMACAddress,omitempty"`
+	Name                       string `json:"name"`
+	Netmask                    string `json:"netmask,omitempty"`
+	PermittedActionType        string `json:"permittedActionType,omitempty"`
+	SharedResourceParentID     string `json:"sharedResourceParentID,omitempty"`
+	Type                       string `json:"type"`
+	Underlay                   bool   `json:"underlay,omitempty"`
+	VnID                       int32  `json:"vnID,omitempty"`
+	CreationDate               int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy              string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate            int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                      string `json:"owner,omitempty"`
+	EntityScope                string `json:"entityScope,omitempty"`
+	ExternalID                 string `json:"externalID,omitempty"`
+	ID                         string `json:"ID,omitempty"`
+	ParentID                   string `json:"parentID,omitempty"`
+	ParentType                 string `json:"parentType,omitempty"`
+}
+
+type SharedNetworkResourceslice []SharedNetworkResource
+
+// Floating IP allocated to a Domain from a FIP pool (AssociatedSharedNetworkResourceID)
+type FloatingIP struct {
+	AccessControl                     bool   `json:"accessControl,omitempty"`
+	Address                           string `json:"address,omitempty"`
+	Assigned                          bool   `json:"assigned,omitempty"`
+	AssignedToObjectType              string `json:"assignedToObjectType,omitempty"`
+	AssociatedSharedNetworkResourceID string `json:"associatedSharedNetworkResourceID"`
+	CreationDate                      int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                     string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                   int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                             string `json:"owner,omitempty"`
+	EntityScope                       string `json:"entityScope,omitempty"`
+	ExternalID                        string `json:"externalID,omitempty"`
+	ID                                string `json:"ID,omitempty"`
+	ParentID                          string `json:"parentID"`
+	ParentType                        string `json:"parentType,omitempty"`
+}
+
+type FloatingIPslice []FloatingIP
+
+////////
+//////// Policy group and Policy group template
+////////
+
+// Type: "SOFTWARE" (default) or "HARDWARE"
+type PolicyGroup struct {
+	Description     string `json:"description,omitempty"`
+	EntityState     string `json:"entityState,omitempty"`
+	External        bool   `json:"external,omitempty"`
+	Name            string `json:"name"`
+	PolicyGroupID   int32  `json:"policyGroupID,omitempty"`
+	TemplateID      string `json:"templateID,omitempty"`
+	Type            string `json:"type,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type PolicyGroupslice []PolicyGroup
+
+type PolicyGrouptemplate struct {
+	Description     string `json:"description,omitempty"`
+	EntityState     string `json:"entityState,omitempty"`
+	External        bool   `json:"external,omitempty"`
+	Name            string `json:"name"`
+	Type            string `json:"type,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate int64  `json:"lastUpdatedDate,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	EntityScope     string `json:"entityScope,omitempty"`
+	ExternalID      string `json:"externalID,omitempty"`
+	ID              string `json:"ID,omitempty"`
+	ParentID        string `json:"parentID"`
+	ParentType      string `json:"parentType,omitempty"`
+}
+
+type PolicyGrouptemplateslice []PolicyGrouptemplate
+
+////////
+//////// Ingress / Egress ACL templates. Policy (ACL) entries are children of an ACL template
+////////
+
+// ParentType is one of "domain", "domaintemplate", "l2domain", "l2domaintemplate"
+type IngressACLTemplate struct {
+	Active                 bool   `json:"active,omitempty"`
+	AllowAddressSpoofing   bool   `json:"allowAddressSpoofing,omitempty"`
+	AllowL2AddressSpoof    bool   `json:"allowL2AddressSpoof,omitempty"`
+	AssocAclTemplateId     string `json:"assocAclTemplateId,omitempty"`
+	AssociatedLiveEntityID string `json:"associatedLiveEntityID,omitempty"`
+	DefaultAllowIP         bool   `json:"defaultAllowIP,omitempty"`
+	DefaultAllowNonIP      bool   `json:"defaultAllowNonIP,omitempty"`
+	Description            string `json:"description,omitempty"`
+	Name                   string `json:"name"`
+	PolicyState            string `json:"policyState,omitempty"`
+	Priority               int    `json:"priority,omitempty"`
+	PriorityType           string `json:"priorityType,omitempty"`
+	CreationDate           int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy          string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate        int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                  string `json:"owner,omitempty"`
+	EntityScope            string `json:"entityScope,omitempty"`
+	ExternalID             string `json:"externalID,omitempty"`
+	ID                     string `json:"ID,omitempty"`
+	ParentID               string `json:"parentID"`
+	ParentType             string `json:"parentType,omitempty"`
+}
+
+type IngressACLTemplateslice []IngressACLTemplate
+
+// ParentType is one of "domain", "domaintemplate", "l2domain", "l2domaintemplate"
+type EgressACLTemplate struct {
+	Active                         bool   `json:"active,omitempty"`
+	AllowAddressSpoofing           bool   `json:"allowAddressSpoofing,omitempty"`
+	AllowL2AddressSpoof            bool   `json:"allowL2AddressSpoof,omitempty"`
+	AssocAclTemplateId             string `json:"assocAclTemplateId,omitempty"`
+	AssociatedLiveEntityID         string `json:"associatedLiveEntityID,omitempty"`
+	DefaultAllowIP                 bool   `json:"defaultAllowIP,omitempty"`
+	DefaultInstallACLImplicitRules bool   `json:"defaultInstallACLImplicitRules,omitempty"`
+	DefaultAllowNonIP              bool   `json:"defaultAllowNonIP,omitempty"`
+	Description                    string `json:"description,omitempty"`
+	Name                           string `json:"name"`
+	PolicyState                    string `json:"policyState,omitempty"`
+	Priority                       int    `json:"priority,omitempty"`
+	PriorityType                   string `json:"priorityType,omitempty"`
+	CreationDate                   int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                  string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                          string `json:"owner,omitempty"`
+	EntityScope                    string `json:"entityScope,omitempty"`
+	ExternalID                     string `json:"externalID,omitempty"`
+	ID                             string `json:"ID,omitempty"`
+	ParentID                       string `json:"parentID"`
+	ParentType                     string `json:"parentType,omitempty"`
+}
+
+type EgressACLTemplateslice []EgressACLTemplate
+
+////////
+//////// Ingress / Egress ACL entries
+////////
+
+// Action: "FORWARD" or "DROP"; Protocol: "ANY" or IANA protocol number (e.g. "6" for TCP); NetworkType / LocationType: "ANY", "SUBNET", "ZONE", "POLICYGROUP", "ENTERPRISE_NETWORK", "NETWORK_MACRO_GROUP", "PUBLIC_NETWORK", ...
+type IngressACLEntry struct {
+	Action                          string `json:"action"`
+	AddressOverride                 string `json:"addressOverride,omitempty"`
+	AssociatedApplicationID         string `json:"associatedApplicationID,omitempty"`
+	AssociatedApplicationObjectID   string `json:"associatedApplicationObjectID,omitempty"`
+	AssociatedApplicationObjectType string `json:"associatedApplicationObjectType,omitempty"`
+	AssociatedLiveEntityID          string `json:"associatedLiveEntityID,omitempty"`
+	Description                     string `json:"description,omitempty"`
+	DestinationPort                 string `json:"destinationPort,omitempty"`
+	DSCP                            string `json:"DSCP,omitempty"`
+	EtherType                       string `json:"etherType"`
+	FlowLoggingEnabled              bool   `json:"flowLoggingEnabled,omitempty"`
+	LocationID                      string `json:"locationID,omitempty"`
+	LocationType                    string `json:"locationType"`
+	MirrorDestinationID             string `json:"mirrorDestinationID,omitempty"`
+	NetworkID                       string `json:"networkID,omitempty"`
+	NetworkType                     string `json:"networkType"`
+	PolicyState                     string `json:"policyState,omitempty"`
+	Priority                        int    `json:"priority"`
+	Protocol                        string `json:"protocol"`
+	Reflexive                       bool   `json:"reflexive,omitempty"`
+	SourcePort                      string `json:"sourcePort,omitempty"`
+	Stateful                        bool   `json:"stateful"`
+	StatsID                         string `json:"statsID,omitempty"`
+	StatsLoggingEnabled             bool   `json:"statsLoggingEnabled,omitempty"`
+	CreationDate                    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                 int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                           string `json:"owner,omitempty"`
+	EntityScope                     string `json:"entityScope,omitempty"`
+	ExternalID                      string `json:"externalID,omitempty"`
+	ID                              string `json:"ID,omitempty"`
+	ParentID                        string `json:"parentID"`
+	ParentType                      string `json:"parentType,omitempty"`
+}
+
+type IngressACLEntryslice []IngressACLEntry
+
+type EgressACLEntry struct {
+	Action                          string `json:"action"`
+	AddressOverride                 string `json:"addressOverride,omitempty"`
+	AssociatedApplicationID         string `json:"associatedApplicationID,omitempty"`
+	AssociatedApplicationObjectID   string `json:"associatedApplicationObjectID,omitempty"`
+	AssociatedApplicationObjectType string `json:"associatedApplicationObjectType,omitempty"`
+	AssociatedLiveEntityID          string `json:"associatedLiveEntityID,omitempty"`
+	Description                     string `json:"description,omitempty"`
+	DestinationPort                 string `json:"destinationPort,omitempty"`
+	DSCP                            string `json:"DSCP,omitempty"`
+	EtherType                       string `json:"etherType"`
+	FlowLoggingEnabled              bool   `json:"flowLoggingEnabled,omitempty"`
+	LocationID                      string `json:"locationID,omitempty"`
+	LocationType                    string `json:"locationType"`
+	MirrorDestinationID             string `json:"mirrorDestinationID,omitempty"`
+	NetworkID                       string `json:"networkID,omitempty"`
+	NetworkType                     string `json:"networkType"`
+	PolicyState                     string `json:"policyState,omitempty"`
+	Priority                        int    `json:"priority"`
+	Protocol                        string `json:"protocol"`
+	Reflexive                       bool   `json:"reflexive,omitempty"`
+	SourcePort                      string `json:"sourcePort,omitempty"`
+	Stateful                        bool   `json:"stateful"`
+	StatsID                         string `json:"statsID,omitempty"`
+	StatsLoggingEnabled             bool   `json:"statsLoggingEnabled,omitempty"`
+	CreationDate                    int64  `json:"creationDate,omitempty"`
+	LastUpdatedBy                   string `json:"lastUpdatedBy,omitempty"`
+	LastUpdatedDate                 int64  `json:"lastUpdatedDate,omitempty"`
+	Owner                           string `json:"owner,omitempty"`
+	EntityScope                     string `json:"entityScope,omitempty"`
+	ExternalID                      string `json:"externalID,omitempty"`
+	ID                              string `json:"ID,omitempty"`
+	ParentID                        string `json:"parentID"`
+	ParentType                      string `json:"parentType,omitempty"`
+}
+
+type EgressACLEntryslice []EgressACLEntry
+
 ////////
 ////////
 ////////
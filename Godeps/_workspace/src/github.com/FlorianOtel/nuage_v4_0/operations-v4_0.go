@@ -0,0 +1,466 @@
+package nuage_v4_0
+
+import (
+	"fmt"
+
+	nuage "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage"
+)
+
+//////// The CRUD code is shared with the other API versions (see the "Typed operations" in package "nuage"). What is left here is the per-entity endpoints and sanity checks.
+
+////////
+//////// VirtualMachine methods and operations. OBS: Some methods have other entities as method receivers (e.g. Subnet / Domain / ... VMInterface List).
+////////
+
+// Caller MUST initialize:
+// - UUID (as e.g. returned upon "ovs-appctl vm/send-event define <XML definition file>" )
+// - Name
+
+// Caller SHOULD initialize at least one interface
+// - vm.Interfaces[0].MAC  -- MAC addr of the first interface
+// - vm.Interfaces[0].VPortID  -- the ID of the VPort where this interface should be connected
+
+// VirtualMachine Create
+func (vm *VirtualMachine) Create(c *nuage.Connection) error {
+	if vm == nil {
+		err := fmt.Errorf("VirtualMachine Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if vm.Name == "" {
+		err := fmt.Errorf("VirtualMachine Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if vm.UUID == "" {
+		err := fmt.Errorf("VirtualMachine Create: Empty UUID, nothing to do")
+		return err
+	}
+
+	return nuage.CreateObject(c, "VirtualMachine", "vms", vm)
+}
+
+// VirtualMachine Delete.  Caller must initialize the VirtualMachine ID (vm.ID)
+func (vm *VirtualMachine) Delete(c *nuage.Connection) error {
+	return nuage.DeleteObject(c, "VirtualMachine", "vms", vm.ID)
+}
+
+// Virtual Machine Get.  Caller must initialize the VirtualMachine ID (vm.ID)
+func (vm *VirtualMachine) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "VirtualMachine", "vms", vm.ID, vm)
+}
+
+// Global list (all VirtualMachines in the Data Center)
+func (vms *VirtualMachineslice) List(c *nuage.Connection) error {
+	return nuage.ListObjects(c, "VirtualMachine", "vms", vms)
+}
+
+////////
+//////// VMInterface operations. OBS: Some are methods for other entities (e.g. Subnet / Domain / ... VMInterface List).
+////////
+
+// VMInterfaces list for a Domain.  Caller must initialize the Domain ID (d.ID)
+func (d *Domain) VMInterfacesList(c *nuage.Connection) ([]VMInterface, error) {
+	if d.ID == "" {
+		err := fmt.Errorf("Domain VMInterfaces List: Empty Domain ID, nothing to do")
+		return nil, err
+	}
+
+	var vmis []VMInterface
+	err := nuage.ListObjects(c, "Domain VMInterfaces", "domains/"+d.ID+"/vminterfaces", &vmis)
+	return vmis, err
+}
+
+// VMInterfaces list for a Subnet.  Caller must initialize the Subnet ID (s.ID)
+func (s *Subnet) VMInterfacesList(c *nuage.Connection) ([]VMInterface, error) {
+	if s.ID == "" {
+		err := fmt.Errorf("Subnet VMInterfaces List: Empty Subnet ID, nothing to do")
+		return nil, err
+	}
+
+	var vmis []VMInterface
+	err := nuage.ListObjects(c, "Subnet VMInterfaces", "subnets/"+s.ID+"/vminterfaces", &vmis)
+	return vmis, err
+}
+
+// VMInterface Delete.  Caller must initialize the VMInterface ID (vmi.ID)
+func (vmi *VMInterface) Delete(c *nuage.Connection) error {
+	return nuage.DeleteObject(c, "VMInterface", "vminterfaces", vmi.ID)
+}
+
+// VMInterface Get.  Caller must initialize the VMInterface ID (vmi.ID)
+func (vmi *VMInterface) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "VMInterface", "vminterfaces", vmi.ID, vmi)
+}
+
+// Global list (all VMInterfaces in the Data Center)
+func (vmis *VMInterfaceslice) List(c *nuage.Connection) error {
+	return nuage.ListObjects(c, "VMInterface", "vminterfaces", vmis)
+}
+
+////////
+//////// VPort operations. OBS: Some are methods for other entities (e.g. Subnet / Domain / ... VPort List).
+////////
+
+// Add VPort to Subnet / Create VPort. Caller must initialize the Subnet ID (s.ID). Additionally, the virtual Port must have:
+// - A Name (vp.Name)
+// - A Type (vp.Type)
+func (s *Subnet) AddVPort(c *nuage.Connection, vp VPort) (VPort, error) {
+	// In the worst case we return what we received
+	if s.ID == "" {
+		err := fmt.Errorf("Subnet Add VPort: Empty Subnet ID, nothing to do")
+		return vp, err
+	}
+
+	//XXX -- TBD: Better sanity checks
+	if vp.Name == "" || vp.Type == "" || vp.AddressSpoofing == "" {
+		err := fmt.Errorf("Subnet Add VPort: Invalid VPort initialization, nothing to do")
+		return vp, err
+	}
+
+	created := vp
+	if err := nuage.CreateObject(c, "Subnet Add VPort", "subnets/"+s.ID+"/vports", &created); err != nil {
+		return vp, err
+	}
+	return created, nil
+}
+
+// VPorts list for a Domain.  Caller must initialize the Domain ID (d.ID)
+func (d *Domain) VPortsList(c *nuage.Connection) ([]VPort, error) {
+	if d.ID == "" {
+		err := fmt.Errorf("Domain VPorts List: Empty Domain ID, nothing to do")
+		return nil, err
+	}
+
+	var vports []VPort
+	err := nuage.ListObjects(c, "Domain VPorts", "domains/"+d.ID+"/vports", &vports)
+	return vports, err
+}
+
+// VPort list for a Subnet.  Caller must initialize the Subnet ID (s.ID)
+func (s *Subnet) VPortsList(c *nuage.Connection) ([]VPort, error) {
+	if s.ID == "" {
+		err := fmt.Errorf("Subnet VPorts List: Empty Subnet ID, nothing to do")
+		return nil, err
+	}
+
+	var vports []VPort
+	err := nuage.ListObjects(c, "Subnet VPorts", "subnets/"+s.ID+"/vports", &vports)
+	return vports, err
+}
+
+// VPort Delete.  Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) Delete(c *nuage.Connection) error {
+	return nuage.DeleteObject(c, "VPort", "vports", vp.ID)
+}
+
+// VPort Get.  Caller must initialize the VPort ID (vp.ID)
+func (vp *VPort) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "VPort", "vports", vp.ID, vp)
+}
+
+////////
+//////// Subnet methods
+////////
+
+// Caller must populate Subnet ID (s.ID)
+func (s *Subnet) Delete(c *nuage.Connection) error {
+	if s == nil {
+		err := fmt.Errorf("Subnet Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	return nuage.DeleteObject(c, "Subnet", "subnets", s.ID)
+}
+
+// Assumes the method receiver was allocated using "new(Subnet)"
+// Caller must populate:
+// - Name (s.Name)
+// - Parent Zone ID (s.ParentID)
+// - Either Subnet Template ID (s.TemplateID) or:
+// - Address (s.Address) -- e.g. "10.24.24.0"
+// - Netmask (s.Netmask) -- e.g. "255.255.255.0"
+func (s *Subnet) Create(c *nuage.Connection) error {
+	if s == nil {
+		err := fmt.Errorf("Subnet Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if s.Name == "" {
+		err := fmt.Errorf("Subnet Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if s.ParentID == "" {
+		err := fmt.Errorf("Subnet Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if s.TemplateID == "" && (s.Address == "" || s.Netmask == "") {
+		err := fmt.Errorf("Subnet Create: Need either Subnet Template ID or Subnet Address & Netmask. Nothing to do")
+		return err
+	}
+
+	return nuage.CreateObject(c, "Subnet", "zones/"+s.ParentID+"/subnets", s)
+}
+
+// Get by Subnet ID (s.ID)
+func (s *Subnet) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "Subnet", "subnets", s.ID, s)
+}
+
+// Global list of subnets, or the subnets of a given Zone ID (parentid)
+func (ss *Subnetslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		return nuage.ListObjects(c, "Subnet", "subnets", ss)
+	}
+	return nuage.ListObjects(c, "Subnet", "zones/"+parentid+"/subnets", ss)
+}
+
+////////
+//////// Zone methods
+////////
+
+// Caller must populate Zone ID (z.ID)
+func (z *Zone) Delete(c *nuage.Connection) error {
+	if z == nil {
+		err := fmt.Errorf("Zone Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	return nuage.DeleteObject(c, "Zone", "zones", z.ID)
+}
+
+// Assumes the method receiver was allocated using "new(Zone)"
+// Caller must populate:
+// - Name (z.Name)
+// - Parent Domain ID (z.ParentID)
+// - Optionally:  Zone Template ID (z.TemplateID)
+func (z *Zone) Create(c *nuage.Connection) error {
+	if z == nil {
+		err := fmt.Errorf("Zone Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if z.Name == "" {
+		err := fmt.Errorf("Zone Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if z.ParentID == "" {
+		err := fmt.Errorf("Zone Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	return nuage.CreateObject(c, "Zone", "domains/"+z.ParentID+"/zones", z)
+}
+
+// Get by Zone ID (z.ID)
+func (z *Zone) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "Zone", "zones", z.ID, z)
+}
+
+// Global list of zones, or the zones of a given Domain ID (parentid)
+func (zs *Zoneslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		return nuage.ListObjects(c, "Zone", "zones", zs)
+	}
+	return nuage.ListObjects(c, "Zone", "domains/"+parentid+"/zones", zs)
+}
+
+////////
+//////// Zone template  methods
+////////
+
+// Caller must populate Zone template ID (zt.ID)
+func (zt *Zonetemplate) Delete(c *nuage.Connection) error {
+	if zt == nil {
+		err := fmt.Errorf("Zone template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	return nuage.DeleteObject(c, "Zone template", "zonetemplates", zt.ID)
+}
+
+// Caller must populate:
+// - Name (zt.Name)
+// - Parent Domain template ID (zt.ParentID)
+func (zt *Zonetemplate) Create(c *nuage.Connection) error {
+	if zt == nil {
+		err := fmt.Errorf("Zone template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if zt.Name == "" {
+		err := fmt.Errorf("Zone template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if zt.ParentID == "" {
+		err := fmt.Errorf("Zone template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	return nuage.CreateObject(c, "Zone template", "domaintemplates/"+zt.ParentID+"/zonetemplates", zt)
+}
+
+// Get by Zone template ID (zt.ID)
+func (zt *Zonetemplate) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "Zone template", "zonetemplates", zt.ID, zt)
+}
+
+// The zone templates of a given Domain template ID (parentid)
+func (zts *Zonetemplateslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Zone template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	return nuage.ListObjects(c, "Zone template", "domaintemplates/"+parentid+"/zonetemplates", zts)
+}
+
+////////
+//////// Domain methods
+////////
+
+// Caller must populate Domain ID (d.ID)
+func (d *Domain) Delete(c *nuage.Connection) error {
+	if d == nil {
+		err := fmt.Errorf("Domain Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	return nuage.DeleteObject(c, "Domain", "domains", d.ID)
+}
+
+// Assumes the method receiver was allocated using "new(Domain)"
+// Caller must populate:
+// - Name (d.Name)
+// - Parent Enterprise ID (d.ParentID)
+// - Domain Template ID (d.TemplateID)
+func (d *Domain) Create(c *nuage.Connection) error {
+	if d == nil {
+		err := fmt.Errorf("Domain Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if d.Name == "" {
+		err := fmt.Errorf("Domain Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if d.ParentID == "" {
+		err := fmt.Errorf("Domain Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	if d.TemplateID == "" {
+		err := fmt.Errorf("Domain Create: Empty Domain template ID, nothing to do")
+		return err
+	}
+
+	return nuage.CreateObject(c, "Domain", "enterprises/"+d.ParentID+"/domains", d)
+}
+
+// Get by Domain ID (d.ID)
+func (d *Domain) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "Domain", "domains", d.ID, d)
+}
+
+// Global list of domains, or the domains of a given Enterprise ID (parentid)
+func (ds *Domainslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		return nuage.ListObjects(c, "Domain", "domains", ds)
+	}
+	return nuage.ListObjects(c, "Domain", "enterprises/"+parentid+"/domains", ds)
+}
+
+////////
+//////// Domaintemplate methods
+////////
+
+// Caller must populate Domain template ID (dt.ID)
+func (dt *Domaintemplate) Delete(c *nuage.Connection) error {
+	if dt == nil {
+		err := fmt.Errorf("Domain template Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	return nuage.DeleteObject(c, "Domain template", "domaintemplates", dt.ID)
+}
+
+// Caller must populate:
+// - Name (dt.Name)
+// - Parent Enterprise ID (dt.ParentID)
+func (dt *Domaintemplate) Create(c *nuage.Connection) error {
+	if dt == nil {
+		err := fmt.Errorf("Domain template Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if dt.Name == "" {
+		err := fmt.Errorf("Domain template Create: Empty Name, nothing to do")
+		return err
+	}
+
+	if dt.ParentID == "" {
+		err := fmt.Errorf("Domain template Create: Empty ParentID, nothing to do")
+		return err
+	}
+
+	return nuage.CreateObject(c, "Domain template", "enterprises/"+dt.ParentID+"/domaintemplates", dt)
+}
+
+// Get by Domain template ID (dt.ID)
+func (dt *Domaintemplate) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "Domain template", "domaintemplates", dt.ID, dt)
+}
+
+// The domain templates of a given Enterprise ID (parentid)
+func (dts *Domaintemplateslice) List(c *nuage.Connection, parentid string) error {
+	if parentid == "" {
+		err := fmt.Errorf("Domain template List: Empty ParentID, nothing to do")
+		return err
+	}
+
+	return nuage.ListObjects(c, "Domain template", "enterprises/"+parentid+"/domaintemplates", dts)
+}
+
+////////
+//////// Enterprise methods
+////////
+
+// Must have a valid ID (org.ID)
+func (org *Enterprise) Delete(c *nuage.Connection) error {
+	if org == nil {
+		err := fmt.Errorf("Enterprise Delete: Empty method receiver, nothing to do")
+		return err
+	}
+
+	return nuage.DeleteObject(c, "Enterprise", "enterprises", org.ID)
+}
+
+// Assumes that the method receiver was allocated using "new(Enterprise)", initialized accordingly (name + description).
+func (org *Enterprise) Create(c *nuage.Connection) error {
+	if org == nil {
+		err := fmt.Errorf("Enterprise Create: Empty method receiver, nothing to do")
+		return err
+	}
+
+	if org.Description == "" {
+		// Default Enterpise Description unless one is specified
+		org.Description = "Created by Golang API driver"
+	}
+
+	return nuage.CreateObject(c, "Enterprise", "enterprises", org)
+}
+
+// GET enterprise by ID (org.ID)
+func (org *Enterprise) Get(c *nuage.Connection) error {
+	return nuage.GetObject(c, "Enterprise", "enterprises", org.ID, org)
+}
+
+// enterprises list
+func (orglist *EnterpriseSlice) List(c *nuage.Connection) error {
+	return nuage.ListObjects(c, "Enterprise", "enterprises", orglist)
+}
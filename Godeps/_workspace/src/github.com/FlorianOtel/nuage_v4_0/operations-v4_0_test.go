@@ -0,0 +1,332 @@
+package nuage_v4_0
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	nuage "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage"
+)
+
+////////
+//////// Fake VSD: Answers the API versions probe, authentication, the VSP (release) list and a generic CRUD for "/nuage/api/v4_0/..." on top of an in-memory store
+////////
+
+type fakevsd struct {
+	sync.Mutex
+	objects map[string]map[string]interface{} // By ID
+	kinds   map[string]string                 // ID -> entity kind (e.g. "domains")
+	nextid  int
+}
+
+func newfakevsd() *httptest.Server {
+	f := &fakevsd{
+		objects: make(map[string]map[string]interface{}),
+		kinds:   make(map[string]string),
+	}
+	return httptest.NewTLSServer(f)
+}
+
+func (f *fakevsd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch {
+	case r.URL.Path == "/nuage":
+		fmt.Fprint(w, `{"versions":[{"version":"v3.2","status":"DEPRECATED"},{"version":"v4.0","status":"CURRENT"}]}`)
+		return
+	case r.URL.Path == "/nuage/api/v1_0/me":
+		fmt.Fprint(w, `[{"APIKey":"key","userName":"user","enterpriseName":"org"}]`)
+		return
+	case r.URL.Path == "/nuage/api/v4_0/vsps":
+		fmt.Fprint(w, `[{"name":"vsp","productVersion":"4.0.R4"}]`)
+		return
+	case !strings.HasPrefix(r.URL.Path, "/nuage/api/v4_0/"):
+		w.WriteHeader(404)
+		return
+	}
+
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/nuage/api/v4_0/"), "/"), "/")
+
+	switch r.Method {
+	case "POST": // <kind> or <parent kind> <parent ID> <kind>
+		var objs []map[string]interface{}
+		body, _ := ioutil.ReadAll(r.Body)
+		var obj map[string]interface{}
+		if err := json.Unmarshal(body, &obj); err != nil {
+			w.WriteHeader(400)
+			return
+		}
+		kind := path[len(path)-1]
+		f.nextid++
+		obj["ID"] = fmt.Sprintf("id-%d", f.nextid)
+		if len(path) == 3 {
+			obj["parentID"] = path[1]
+			obj["parentType"] = strings.TrimSuffix(path[0], "s")
+		}
+		f.objects[obj["ID"].(string)] = obj
+		f.kinds[obj["ID"].(string)] = kind
+		w.WriteHeader(201)
+		json.NewEncoder(w).Encode(append(objs, obj))
+
+	case "GET":
+		objs := []map[string]interface{}{}
+		switch len(path) {
+		case 1: // Global list
+			for id, obj := range f.objects {
+				if f.kinds[id] == path[0] {
+					objs = append(objs, obj)
+				}
+			}
+		case 2: // By ID
+			obj, ok := f.objects[path[1]]
+			if !ok || f.kinds[path[1]] != path[0] {
+				w.WriteHeader(404)
+				return
+			}
+			objs = append(objs, obj)
+		case 3: // Children of a given parent
+			for id, obj := range f.objects {
+				if f.kinds[id] == path[2] && obj["parentID"] == path[1] {
+					objs = append(objs, obj)
+				}
+			}
+		}
+		if len(objs) == 0 {
+			// The VSD replies with an empty body to empty lists
+			return
+		}
+		json.NewEncoder(w).Encode(objs)
+
+	case "DELETE":
+		if _, ok := f.objects[path[1]]; !ok {
+			w.WriteHeader(404)
+			return
+		}
+		// Enterprises need confirmation
+		if path[0] == "enterprises" && r.URL.Query().Get("responseChoice") != "1" {
+			w.WriteHeader(300)
+			return
+		}
+		delete(f.objects, path[1])
+		delete(f.kinds, path[1])
+		w.WriteHeader(204)
+	}
+}
+
+func fakeconn(t *testing.T, url string) *nuage.Connection {
+	c := &nuage.Connection{Url: url}
+	if err := c.ProbeVersions(); err != nil {
+		t.Fatalf("ProbeVersions: %s", err)
+	}
+	if err := c.NegotiateApivers("v4_0", "v3_2"); err != nil {
+		t.Fatalf("NegotiateApivers: %s", err)
+	}
+	if c.Apivers != "v4_0" {
+		t.Fatalf("Negotiated API version: got [%s], want [v4_0]", c.Apivers)
+	}
+	if err := c.Connect("org", "user", "pass"); err != nil {
+		t.Fatalf("Connect: %s", err)
+	}
+	return c
+}
+
+func TestConnectVersions(t *testing.T) {
+	srv := newfakevsd()
+	defer srv.Close()
+
+	c := fakeconn(t, srv.URL)
+
+	if c.CurrentApivers != "v4.0" {
+		t.Errorf("Current API version: got [%s], want [v4.0]", c.CurrentApivers)
+	}
+	if c.Release != "4.0.R4" {
+		t.Errorf("VSD release: got [%s], want [4.0.R4]", c.Release)
+	}
+	if c.ApiversStatus() != "CURRENT" {
+		t.Errorf("API version status: got [%s], want [CURRENT]", c.ApiversStatus())
+	}
+
+	c = &nuage.Connection{Url: srv.URL, Apivers: "v5_0"}
+	if err := c.Connect("org", "user", "pass"); err == nil {
+		t.Errorf("Connect with an unsupported API version should fail")
+	}
+}
+
+func TestEnterprise(t *testing.T) {
+	srv := newfakevsd()
+	defer srv.Close()
+	c := fakeconn(t, srv.URL)
+
+	org := new(Enterprise)
+	org.Name = "org"
+	org.BGPEnabled = true
+	org.LocalAS = 65000
+	if err := org.Create(c); err != nil {
+		t.Fatalf("Enterprise Create: %s", err)
+	}
+	if org.ID == "" || org.Description == "" {
+		t.Errorf("Enterprise Create: got %#v", org)
+	}
+
+	got := new(Enterprise)
+	got.ID = org.ID
+	if err := got.Get(c); err != nil {
+		t.Fatalf("Enterprise Get: %s", err)
+	}
+	if got.Name != "org" || !got.BGPEnabled || got.LocalAS != 65000 {
+		t.Errorf("Enterprise Get: got %#v", got)
+	}
+
+	var orglist EnterpriseSlice
+	if err := orglist.List(c); err != nil || len(orglist) != 1 {
+		t.Errorf("Enterprise List: got %d entries, error: %v", len(orglist), err)
+	}
+
+	// Requires the deletion confirmation
+	if err := org.Delete(c); err != nil {
+		t.Fatalf("Enterprise Delete: %s", err)
+	}
+	if err := got.Get(c); err == nil {
+		t.Errorf("Enterprise Get after Delete should fail")
+	}
+
+	orglist = nil
+	if err := orglist.List(c); err != nil || len(orglist) != 0 {
+		t.Errorf("Enterprise List after Delete: got %d entries, error: %v", len(orglist), err)
+	}
+}
+
+func TestDomainHierarchy(t *testing.T) {
+	srv := newfakevsd()
+	defer srv.Close()
+	c := fakeconn(t, srv.URL)
+
+	org := new(Enterprise)
+	org.Name = "org"
+	if err := org.Create(c); err != nil {
+		t.Fatalf("Enterprise Create: %s", err)
+	}
+
+	dt := new(Domaintemplate)
+	dt.Name = "dt"
+	dt.ParentID = org.ID
+	dt.DPIEnabled = "ENABLED"
+	if err := dt.Create(c); err != nil {
+		t.Fatalf("Domain template Create: %s", err)
+	}
+
+	var dts Domaintemplateslice
+	if err := dts.List(c, org.ID); err != nil || len(dts) != 1 || dts[0].DPIEnabled != "ENABLED" {
+		t.Errorf("Domain template List: got %#v, error: %v", dts, err)
+	}
+
+	zt := new(Zonetemplate)
+	zt.Name = "zt"
+	zt.ParentID = dt.ID
+	if err := zt.Create(c); err != nil {
+		t.Fatalf("Zone template Create: %s", err)
+	}
+
+	var zts Zonetemplateslice
+	if err := zts.List(c, dt.ID); err != nil || len(zts) != 1 {
+		t.Errorf("Zone template List: got %#v, error: %v", zts, err)
+	}
+
+	domain := new(Domain)
+	domain.Name = "domain"
+	domain.ParentID = org.ID
+	domain.TemplateID = dt.ID
+	domain.BGPEnabled = true
+	domain.UnderlayEnabled = "ENABLED"
+	if err := domain.Create(c); err != nil {
+		t.Fatalf("Domain Create: %s", err)
+	}
+
+	d := new(Domain)
+	d.ID = domain.ID
+	if err := d.Get(c); err != nil {
+		t.Fatalf("Domain Get: %s", err)
+	}
+	if !d.BGPEnabled || d.UnderlayEnabled != "ENABLED" || d.ParentID != org.ID {
+		t.Errorf("Domain Get: got %#v", d)
+	}
+
+	var ds Domainslice
+	if err := ds.List(c, org.ID); err != nil || len(ds) != 1 {
+		t.Errorf("Domain List: got %#v, error: %v", ds, err)
+	}
+
+	zone := new(Zone)
+	zone.Name = "zone"
+	zone.ParentID = domain.ID
+	if err := zone.Create(c); err != nil {
+		t.Fatalf("Zone Create: %s", err)
+	}
+
+	subnet := new(Subnet)
+	subnet.Name = "subnet"
+	subnet.ParentID = zone.ID
+	if err := subnet.Create(c); err == nil {
+		t.Errorf("Subnet Create without template nor address should fail")
+	}
+	subnet.Address = "10.24.24.0"
+	subnet.Netmask = "255.255.255.0"
+	subnet.UnderlayEnabled = "DISABLED"
+	if err := subnet.Create(c); err != nil {
+		t.Fatalf("Subnet Create: %s", err)
+	}
+
+	var ss Subnetslice
+	if err := ss.List(c, zone.ID); err != nil || len(ss) != 1 || ss[0].UnderlayEnabled != "DISABLED" {
+		t.Errorf("Subnet List: got %#v, error: %v", ss, err)
+	}
+
+	var vport VPort
+	vport.Name = "vport"
+	vport.Type = "VM"
+	vport.AddressSpoofing = "INHERITED"
+	vport.DPIEnabled = "INHERITED"
+	vp, err := subnet.AddVPort(c, vport)
+	if err != nil {
+		t.Fatalf("Subnet Add VPort: %s", err)
+	}
+
+	vports, err := subnet.VPortsList(c)
+	if err != nil || len(vports) != 1 || vports[0].ID != vp.ID {
+		t.Errorf("Subnet VPorts List: got %#v, error: %v", vports, err)
+	}
+
+	var vm VirtualMachine
+	vm.Name = "vm"
+	vm.UUID = "2b7a9e44-3c2a-4a8e-9b2f-3f1e0b8d8a11"
+	vm.OrchestrationID = "orchestrator"
+	var vmi VMInterface
+	vmi.MAC = "52:54:00:00:00:01"
+	vmi.VPortID = vp.ID
+	vm.Interfaces = append(vm.Interfaces, vmi)
+	if err := (&vm).Create(c); err != nil {
+		t.Fatalf("VirtualMachine Create: %s", err)
+	}
+
+	var vms VirtualMachineslice
+	if err := vms.List(c); err != nil || len(vms) != 1 || vms[0].OrchestrationID != "orchestrator" {
+		t.Errorf("VirtualMachine List: got %#v, error: %v", vms, err)
+	}
+
+	// Tear down, bottom up
+	for _, del := range []func(*nuage.Connection) error{vm.Delete, vp.Delete, subnet.Delete, zone.Delete, domain.Delete, zt.Delete, dt.Delete, org.Delete} {
+		if err := del(c); err != nil {
+			t.Errorf("Delete: %s", err)
+		}
+	}
+
+	if err := (&Subnet{}).Delete(c); err == nil {
+		t.Errorf("Subnet Delete with empty ID should fail")
+	}
+}
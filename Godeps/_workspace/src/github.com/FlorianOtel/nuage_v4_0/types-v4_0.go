@@ -0,0 +1,141 @@
+package nuage_v4_0
+
+import (
+	nuage_v3_2 "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage_v3_2"
+)
+
+//////// The API v4.0 entities are a superset of their API v3.2 counterparts: Each type embeds the nuage_v3_2 one and only adds the fields introduced in API v4.0.
+//////// OBS: JSON encoding / decoding flattens the embedded struct, so the wire format is the same as if the fields were declared here.
+
+////////
+//////// VirtualMachine and related
+////////
+
+type VirtualMachine struct {
+	nuage_v3_2.VirtualMachine
+	// Shadows the (v3.2) embedded one, so that the interfaces are decoded as API v4.0 VMInterface's
+	Interfaces []VMInterface `json:"interfaces,omitempty"`
+	// Added in API v4.0
+	ComputeProvisioned bool   `json:"computeProvisioned,omitempty"`
+	OrchestrationID    string `json:"orchestrationID,omitempty"`
+}
+
+type VirtualMachineslice []VirtualMachine
+
+////////
+//////// VMInterface
+////////
+
+type VMInterface struct {
+	nuage_v3_2.VMInterface
+	// Added in API v4.0
+	IPv6Address string `json:"IPv6Address,omitempty"`
+	IPv6Gateway string `json:"IPv6Gateway,omitempty"`
+}
+
+type VMInterfaceslice []VMInterface
+
+////////
+//////// vPort
+////////
+
+type VPort struct {
+	nuage_v3_2.VPort
+	// Added in API v4.0
+	DPIEnabled         string `json:"DPIEnabled,omitempty"`
+	GatewayMACMoveRole string `json:"gatewayMACMoveRole,omitempty"`
+	SegmentationID     int    `json:"segmentationID,omitempty"`
+	SegmentationType   string `json:"segmentationType,omitempty"`
+	TrunkRole          string `json:"trunkRole,omitempty"`
+	AssociatedTrunkID  string `json:"associatedTrunkID,omitempty"`
+}
+
+////////
+//////// Subnet
+////////
+
+type Subnet struct {
+	nuage_v3_2.Subnet
+	// Added in API v4.0
+	DPIEnabled      string `json:"DPIEnabled,omitempty"`
+	EntityState     string `json:"entityState,omitempty"`
+	IPv6Address     string `json:"IPv6Address,omitempty"`
+	IPv6Gateway     string `json:"IPv6Gateway,omitempty"`
+	ResourceType    string `json:"resourceType,omitempty"`
+	Underlay        bool   `json:"underlay,omitempty"`
+	UnderlayEnabled string `json:"underlayEnabled,omitempty"`
+	UseGlobalMAC    string `json:"useGlobalMAC,omitempty"`
+}
+
+type Subnetslice []Subnet
+
+////////
+//////// Zone
+////////
+
+type Zone struct {
+	nuage_v3_2.Zone
+	// Added in API v4.0
+	DPIEnabled string `json:"DPIEnabled,omitempty"`
+}
+
+type Zoneslice []Zone
+
+////////
+//////// Zone template
+////////
+
+type Zonetemplate struct {
+	nuage_v3_2.Zonetemplate
+	// Added in API v4.0
+	DPIEnabled string `json:"DPIEnabled,omitempty"`
+}
+
+type Zonetemplateslice []Zonetemplate
+
+////////
+//////// Domain
+////////
+
+type Domain struct {
+	nuage_v3_2.Domain
+	// Added in API v4.0
+	AdvertiseCriteria     string `json:"advertiseCriteria,omitempty"`
+	AssociatedPATMapperID string `json:"associatedPATMapperID,omitempty"`
+	BGPEnabled            bool   `json:"BGPEnabled,omitempty"`
+	DomainID              int64  `json:"domainID,omitempty"`
+	DomainVLANID          int    `json:"domainVLANID,omitempty"`
+	DPIEnabled            string `json:"DPIEnabled,omitempty"`
+	FIPUnderlay           bool   `json:"FIPUnderlay,omitempty"`
+	LocalAS               int    `json:"localAS,omitempty"`
+	UnderlayEnabled       string `json:"underlayEnabled,omitempty"`
+}
+
+type Domainslice []Domain
+
+////////
+//////// Domain template
+////////
+
+type Domaintemplate struct {
+	nuage_v3_2.Domaintemplate
+	// Added in API v4.0
+	AssociatedPATMapperID string `json:"associatedPATMapperID,omitempty"`
+	DPIEnabled            string `json:"DPIEnabled,omitempty"`
+}
+
+type Domaintemplateslice []Domaintemplate
+
+////////
+//////// Enterprise
+////////
+
+type Enterprise struct {
+	nuage_v3_2.Enterprise
+	// Added in API v4.0
+	BGPEnabled        bool `json:"BGPEnabled,omitempty"`
+	DictionaryVersion int  `json:"dictionaryVersion,omitempty"`
+	LocalAS           int  `json:"localAS,omitempty"`
+}
+
+type EnterpriseSlice []Enterprise
@@ -0,0 +1,379 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+
+	nuage "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage"
+
+	nuage_v3_2 "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage_v3_2"
+
+	nuage_v4_0 "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage_v4_0"
+
+	log "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/Sirupsen/logrus"
+)
+
+////////
+//////// API version backends. There is a single set of shell verbs, written against the nuage_v3_2 types. The connection API version selects the backend:
+//////// - "v3_2": nuage_v3_2.
+//////// - "v4_0": nuage_v4_0 for the verbs in "ported_v4_0", operating on the nuage_v4_0 type that embeds the shell's nuage_v3_2 one (so the fields added in API v4.0 are printed as well).
+////////   Any other verb is served over API v3.2 on the same VSD session -- and refused if the VSD no longer supports API v3.2.
+////////
+
+// Shell verbs with a nuage_v4_0 backend. Keys as returned by "verbkey"
+var ported_v4_0 = map[string]bool{
+	"GET enterprises":                        true,
+	"GET enterprises <ID>":                   true,
+	"GET enterprises <ID> domaintemplates":   true,
+	"GET enterprises <ID> domains":           true,
+	"GET domaintemplates <ID>":               true,
+	"GET domaintemplates <ID> zonetemplates": true,
+	"GET domains":                            true,
+	"GET domains <ID>":                       true,
+	"GET domains <ID> vports":                true,
+	"GET domains <ID> vminterfaces":          true,
+	"GET zonetemplates <ID>":                 true,
+	"GET zones":                              true,
+	"GET zones <ID>":                         true,
+	"GET subnets":                            true,
+	"GET subnets <ID>":                       true,
+	"GET subnets <ID> vports":                true,
+	"GET subnets <ID> vminterfaces":          true,
+	"GET vports <ID>":                        true,
+	"GET vminterfaces":                       true,
+	"GET vminterfaces <ID>":                  true,
+	"GET vms":                                true,
+	"GET vms <ID>":                           true,
+	"CREATE enterprise":                      true,
+	"CREATE domaintemplate":                  true,
+	"CREATE domain":                          true,
+	"CREATE zonetemplate":                    true,
+	"CREATE zone":                            true,
+	"CREATE subnet":                          true,
+	"CREATE vport":                           true,
+	"CREATE vm":                              true,
+	"DELETE enterprise":                      true,
+	"DELETE domaintemplate":                  true,
+	"DELETE domain":                          true,
+	"DELETE zonetemplate":                    true,
+	"DELETE zone":                            true,
+	"DELETE subnet":                          true,
+	"DELETE vport":                           true,
+	"DELETE vminterface":                     true,
+	"DELETE vm":                              true,
+}
+
+// The shape of a shell command, e.g. "GET enterprises <ID> domains" or "CREATE zone". For verbs other than GET only the entity is kept
+func verbkey(verb string, args ...string) string {
+	key := verb
+	for i, a := range args {
+		if verb != "GET" && i > 0 {
+			break
+		}
+		if verb == "GET" && i == 1 && a != "--tag" {
+			a = "<ID>"
+		}
+		key = key + " " + a
+	}
+	return key
+}
+
+// Run a shell verb on the backend selected by the connection API version
+func dispatch(verb string, handler func(...string) (string, error)) func(...string) (string, error) {
+	return func(args ...string) (string, error) {
+		if myconn.Apivers != "v4_0" || ported_v4_0[verbkey(verb, args...)] {
+			return handler(args...)
+		}
+
+		conn := v3_2conn()
+		// An empty list of versions means the VSD could not be probed. Let the API calls tell.
+		if len(myconn.Versions) != 0 && conn.ApiversStatus() == "" {
+			return "", fmt.Errorf("[%s] is not available with API version [%s], and the VSD does not support API version [v3_2]", verbkey(verb, args...), myconn.Apivers)
+		}
+
+		log.Debugf("[%s] is not available with API version [%s], using API version [v3_2]", verbkey(verb, args...), myconn.Apivers)
+		saved := myconn
+		myconn = conn
+		defer func() { myconn = saved }()
+		return handler(args...)
+	}
+}
+
+// The connection to use with the nuage_v3_2 library proper -- i.e. for entities without an API version specific backend
+func v3_2conn() *nuage.Connection {
+	if myconn.Apivers == "v4_0" {
+		return myconn.WithApivers("v3_2")
+	}
+	return myconn
+}
+
+////////
+//////// Version-selected operations on the shell (nuage_v3_2) entities. They return what is to be printed: The entity itself, or the nuage_v4_0 entity embedding it.
+////////
+
+// The nuage_v4_0 entity embedding "obj", and how to copy it back into "obj" once done. "obj" itself unless the connection API version is "v4_0"
+func versioned(obj interface{}) (interface{}, func()) {
+	if myconn.Apivers != "v4_0" {
+		return obj, func() {}
+	}
+
+	switch o := obj.(type) {
+	case *nuage_v3_2.Enterprise:
+		o4 := &nuage_v4_0.Enterprise{Enterprise: *o}
+		return o4, func() { *o = o4.Enterprise }
+	case *nuage_v3_2.Domaintemplate:
+		o4 := &nuage_v4_0.Domaintemplate{Domaintemplate: *o}
+		return o4, func() { *o = o4.Domaintemplate }
+	case *nuage_v3_2.Domain:
+		o4 := &nuage_v4_0.Domain{Domain: *o}
+		return o4, func() { *o = o4.Domain }
+	case *nuage_v3_2.Zonetemplate:
+		o4 := &nuage_v4_0.Zonetemplate{Zonetemplate: *o}
+		return o4, func() { *o = o4.Zonetemplate }
+	case *nuage_v3_2.Zone:
+		o4 := &nuage_v4_0.Zone{Zone: *o}
+		return o4, func() { *o = o4.Zone }
+	case *nuage_v3_2.Subnet:
+		o4 := &nuage_v4_0.Subnet{Subnet: *o}
+		return o4, func() { *o = o4.Subnet }
+	case *nuage_v3_2.VPort:
+		o4 := &nuage_v4_0.VPort{VPort: *o}
+		return o4, func() { *o = o4.VPort }
+	case *nuage_v3_2.VMInterface:
+		o4 := &nuage_v4_0.VMInterface{VMInterface: *o}
+		return o4, func() { *o = o4.VMInterface }
+	case *nuage_v3_2.VirtualMachine:
+		// The interfaces are not part of the embedded struct (see nuage_v4_0.VirtualMachine)
+		o4 := &nuage_v4_0.VirtualMachine{VirtualMachine: *o}
+		for _, vmi := range o.Interfaces {
+			o4.Interfaces = append(o4.Interfaces, nuage_v4_0.VMInterface{VMInterface: vmi})
+		}
+		return o4, func() { *o = vm_v3_2(*o4) }
+	}
+
+	log.Debugf("No API version [%s] backend for: %T", myconn.Apivers, obj)
+	return obj, func() {}
+}
+
+// The nuage_v3_2 VirtualMachine embedded in "vm", with its interfaces
+func vm_v3_2(vm nuage_v4_0.VirtualMachine) nuage_v3_2.VirtualMachine {
+	v := vm.VirtualMachine
+	v.Interfaces = nil
+	for _, vmi := range vm.Interfaces {
+		v.Interfaces = append(v.Interfaces, vmi.VMInterface)
+	}
+	return v
+}
+
+type getter interface {
+	Get(*nuage.Connection) error
+}
+
+type creator interface {
+	Create(*nuage.Connection) error
+}
+
+type deleter interface {
+	Delete(*nuage.Connection) error
+}
+
+func apiget(obj getter) (interface{}, error) {
+	shown, done := versioned(obj)
+	err := shown.(getter).Get(myconn)
+	done()
+	return shown, err
+}
+
+func apicreate(obj creator) (interface{}, error) {
+	shown, done := versioned(obj)
+	err := shown.(creator).Create(myconn)
+	done()
+	return shown, err
+}
+
+func apidelete(obj deleter) error {
+	shown, _ := versioned(obj)
+	return shown.(deleter).Delete(myconn)
+}
+
+// Add VPort "vp" to Subnet "s". Returns the created VPort along with what is to be printed
+func apiaddvport(s *nuage_v3_2.Subnet, vp nuage_v3_2.VPort) (nuage_v3_2.VPort, interface{}, error) {
+	if myconn.Apivers != "v4_0" {
+		vp, err := s.AddVPort(myconn, vp)
+		return vp, vp, err
+	}
+
+	s4 := nuage_v4_0.Subnet{Subnet: *s}
+	vp4, err := s4.AddVPort(myconn, nuage_v4_0.VPort{VPort: vp})
+	return vp4.VPort, vp4, err
+}
+
+// List into "list", a pointer to one of the shell's (nuage_v3_2) slices. "parentid" for those lists that can be restricted to a parent entity
+func apilist(list interface{}, parentid string) ([]interface{}, error) {
+	v4 := myconn.Apivers == "v4_0"
+
+	switch l := list.(type) {
+	case *nuage_v3_2.EnterpriseSlice:
+		if !v4 {
+			err := l.List(myconn)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.EnterpriseSlice
+		err := l4.List(myconn)
+		for _, o := range l4 {
+			*l = append(*l, o.Enterprise)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.Domaintemplateslice:
+		if !v4 {
+			err := l.List(myconn, parentid)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.Domaintemplateslice
+		err := l4.List(myconn, parentid)
+		for _, o := range l4 {
+			*l = append(*l, o.Domaintemplate)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.Domainslice:
+		if !v4 {
+			err := l.List(myconn, parentid)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.Domainslice
+		err := l4.List(myconn, parentid)
+		for _, o := range l4 {
+			*l = append(*l, o.Domain)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.Zonetemplateslice:
+		if !v4 {
+			err := l.List(myconn, parentid)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.Zonetemplateslice
+		err := l4.List(myconn, parentid)
+		for _, o := range l4 {
+			*l = append(*l, o.Zonetemplate)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.Zoneslice:
+		if !v4 {
+			err := l.List(myconn, parentid)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.Zoneslice
+		err := l4.List(myconn, parentid)
+		for _, o := range l4 {
+			*l = append(*l, o.Zone)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.Subnetslice:
+		if !v4 {
+			err := l.List(myconn, parentid)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.Subnetslice
+		err := l4.List(myconn, parentid)
+		for _, o := range l4 {
+			*l = append(*l, o.Subnet)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.VMInterfaceslice:
+		if !v4 {
+			err := l.List(myconn)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.VMInterfaceslice
+		err := l4.List(myconn)
+		for _, o := range l4 {
+			*l = append(*l, o.VMInterface)
+		}
+		return entries(l4), err
+	case *nuage_v3_2.VirtualMachineslice:
+		if !v4 {
+			err := l.List(myconn)
+			return entries(*l), err
+		}
+		var l4 nuage_v4_0.VirtualMachineslice
+		err := l4.List(myconn)
+		for _, o := range l4 {
+			*l = append(*l, vm_v3_2(o))
+		}
+		return entries(l4), err
+	}
+
+	return nil, fmt.Errorf("No API version [%s] backend for: %T", myconn.Apivers, list)
+}
+
+// VPorts of a Domain or Subnet ("parent"), along with what is to be printed
+func apivportslist(parent interface{}) ([]nuage_v3_2.VPort, []interface{}, error) {
+	var (
+		vports  []nuage_v3_2.VPort
+		vports4 []nuage_v4_0.VPort
+		err     error
+	)
+
+	switch p := parent.(type) {
+	case *nuage_v3_2.Domain:
+		if myconn.Apivers != "v4_0" {
+			vports, err = p.VPortsList(myconn)
+			return vports, entries(vports), err
+		}
+		vports4, err = (&nuage_v4_0.Domain{Domain: *p}).VPortsList(myconn)
+	case *nuage_v3_2.Subnet:
+		if myconn.Apivers != "v4_0" {
+			vports, err = p.VPortsList(myconn)
+			return vports, entries(vports), err
+		}
+		vports4, err = (&nuage_v4_0.Subnet{Subnet: *p}).VPortsList(myconn)
+	default:
+		return nil, nil, fmt.Errorf("No VPorts list for: %T", parent)
+	}
+
+	for _, vp := range vports4 {
+		vports = append(vports, vp.VPort)
+	}
+	return vports, entries(vports4), err
+}
+
+// VMInterfaces of a Domain or Subnet ("parent"), along with what is to be printed
+func apivminterfaceslist(parent interface{}) ([]nuage_v3_2.VMInterface, []interface{}, error) {
+	var (
+		vmis  []nuage_v3_2.VMInterface
+		vmis4 []nuage_v4_0.VMInterface
+		err   error
+	)
+
+	switch p := parent.(type) {
+	case *nuage_v3_2.Domain:
+		if myconn.Apivers != "v4_0" {
+			vmis, err = p.VMInterfacesList(myconn)
+			return vmis, entries(vmis), err
+		}
+		vmis4, err = (&nuage_v4_0.Domain{Domain: *p}).VMInterfacesList(myconn)
+	case *nuage_v3_2.Subnet:
+		if myconn.Apivers != "v4_0" {
+			vmis, err = p.VMInterfacesList(myconn)
+			return vmis, entries(vmis), err
+		}
+		vmis4, err = (&nuage_v4_0.Subnet{Subnet: *p}).VMInterfacesList(myconn)
+	default:
+		return nil, nil, fmt.Errorf("No VMInterfaces list for: %T", parent)
+	}
+
+	for _, vmi := range vmis4 {
+		vmis = append(vmis, vmi.VMInterface)
+	}
+	return vmis, entries(vmis4), err
+}
+
+// The entries of a slice, for printing
+func entries(list interface{}) []interface{} {
+	v := reflect.ValueOf(list)
+	shown := make([]interface{}, v.Len())
+	for i := range shown {
+		shown[i] = v.Index(i).Interface()
+	}
+	return shown
+}
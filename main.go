@@ -4,6 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
 
 	nuage "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/FlorianOtel/nuage"
 
@@ -14,6 +19,7 @@ import (
 	log "github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/Sirupsen/logrus"
 
 	"github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/abiosoft/ishell"
+	"github.com/FlorianOtel/gonuageshell/Godeps/_workspace/src/github.com/howeyc/gopass"
 )
 
 var (
@@ -25,6 +31,15 @@ var (
 		Apivers: "v3_2",
 	}
 
+	// Nuage API versions implemented by this shell, in order of preference
+	apiversions = []string{"v4_0", "v3_2"}
+
+	// How long "wait job" waits for the Job to finish, unless specified otherwise
+	jobtimeout = 5 * time.Minute
+
+	// How long "resync ... --wait" waits for each VirtualMachine, unless specified otherwise
+	resynctimeout = 5 * time.Minute
+
 	// Nuage API connection defaults. We need to keep them as global vars since commands can be invoked in whatever order.
 	org  = "org"
 	user = "user"
@@ -52,11 +67,37 @@ func main() {
 	shell.Register("makeconn", makeconn)
 
 	// Enterprise CRUD operations
-	shell.Register("GET", Get)
+	shell.Register("GET", dispatch("GET", Get))
+
+	shell.Register("CREATE", dispatch("CREATE", Create))
+
+	shell.Register("DELETE", dispatch("DELETE", Delete))
+
+	shell.Register("ASSIGN", dispatch("ASSIGN", Assign))
+
+	shell.Register("UNASSIGN", dispatch("UNASSIGN", Unassign))
+
+	// Asynchronous VSD jobs
+	shell.Register("wait", dispatch("wait", Wait))
 
-	shell.Register("CREATE", Create)
+	// Traffic statistics
+	shell.Register("stats", dispatch("stats", Stats))
 
-	shell.Register("DELETE", Delete)
+	// Metadata: Key / value tags on any entity
+	shell.Register("tag", dispatch("tag", Tag))
+
+	shell.Register("untag", dispatch("untag", Untag))
+
+	// Alarms and Event logs
+	shell.Register("alarms", dispatch("alarms", Alarms))
+
+	shell.Register("events", dispatch("events", Events))
+
+	// Draft mode policy editing
+	shell.Register("policy", dispatch("policy", Policy))
+
+	// VirtualMachine resync
+	shell.Register("resync", dispatch("resync", Resync))
 
 	// shell.Register("EnterprisesList", EnterprisesList)
 
@@ -66,679 +107,4905 @@ func main() {
 	shell.Start()
 }
 
-func Delete(args ...string) (string, error) {
-	// Format: <entity> <ID>
-	if len(args) != 2 {
-		return "Format:\n    DELETE <entity> <ID>", nil
+// Member assignment. Format: <group entity> <group ID> <member ID> [ <member ID> ... ]
+func Assign(args ...string) (string, error) {
+	if len(args) < 3 {
+		return "Format:\n    ASSIGN policygroup <Policy group ID> <VPort ID> [ <VPort ID> ... ]\n    ASSIGN floatingip <Floating IP ID> <VPort ID>\n    ASSIGN networkmacrogroup <Network macro group ID> <Network macro ID> [ <Network macro ID> ... ]\n    ASSIGN group <Group ID> <User ID> [ <User ID> ... ]\n    ASSIGN redirectiontarget <Redirection target ID> <VPort ID> [ <VPort ID> ... ]\n    ASSIGN multicastlist <Multicast list ID> <Channel map ID> [ <Channel map ID> ... ]\n    ASSIGN multicastchannelmap <Channel map ID> <VPort ID> [ send ]\n    ASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>\n    ASSIGN multinicvport <Multi-NIC VPort ID> <VPort ID> [ <VPort ID> ... ]", nil
 	}
+
 	entity := args[0]
 	id := args[1]
+
 	switch entity {
-	case "enterprise": // DELETE enterprise <ID>
-		org := new(nuage_v3_2.Enterprise)
-		org.ID = id
-		err := org.Delete(myconn)
+	case "policygroup": // ASSIGN policygroup <Policy group ID> <VPort ID> [ <VPort ID> ... ]
+		pg := new(nuage_v3_2.PolicyGroup)
+		pg.ID = id
+		err := pg.AssignVPorts(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		return "", err
-	case "domaintemplate": // DELETE domaintemplate <ID>
-		dt := new(nuage_v3_2.Domaintemplate)
-		dt.ID = id
-		err := dt.Delete(myconn)
+		return "Policy group VPorts Assign -- done", err
+
+	case "floatingip": // ASSIGN floatingip <Floating IP ID> <VPort ID>
+		if len(args) != 3 {
+			return "Format:\n    ASSIGN floatingip <Floating IP ID> <VPort ID>", nil
+		}
+		vport := new(nuage_v3_2.VPort)
+		vport.ID = args[2]
+		err := vport.AssociateFloatingIP(myconn, id)
 		if err != nil {
 			return "", err
 		}
-		return "", err
-	case "domain": // DELETE domain <ID>
-		domain := new(nuage_v3_2.Domain)
-		domain.ID = id
-		err := domain.Delete(myconn)
+		return "Floating IP Associate -- done", err
+
+	case "networkmacrogroup": // ASSIGN networkmacrogroup <Network macro group ID> <Network macro ID> [ <Network macro ID> ... ]
+		nmg := new(nuage_v3_2.NetworkMacroGroup)
+		nmg.ID = id
+		err := nmg.AssignEnterpriseNetworks(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		return "", err
-	case "zonetemplate": // DELETE zonetemplate <ID>
-		zt := new(nuage_v3_2.Zonetemplate)
-		zt.ID = id
-		err := zt.Delete(myconn)
+		return "Network macro group members Assign -- done", err
+
+	case "group": // ASSIGN group <Group ID> <User ID> [ <User ID> ... ]
+		g := new(nuage_v3_2.Group)
+		g.ID = id
+		err := g.AddUsers(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		return "", err
+		return "Group Users Add -- done", err
 
-	case "zone": // DELETE zone <ID>
-		zone := new(nuage_v3_2.Zone)
-		zone.ID = id
-		err := zone.Delete(myconn)
+	case "redirectiontarget": // ASSIGN redirectiontarget <Redirection target ID> <VPort ID> [ <VPort ID> ... ]
+		rt := new(nuage_v3_2.RedirectionTarget)
+		rt.ID = id
+		err := rt.AssignVPorts(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		return "", err
+		return "Redirection target VPorts Assign -- done", err
 
-	case "subnet": // DELETE subnet <ID>
-		subnet := new(nuage_v3_2.Subnet)
-		subnet.ID = id
-		err := subnet.Delete(myconn)
+	case "multicastlist": // ASSIGN multicastlist <Multicast list ID> <Channel map ID> [ <Channel map ID> ... ]
+		ml := new(nuage_v3_2.MulticastList)
+		ml.ID = id
+		err := ml.AssignChannelMaps(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		return "", err
+		return "Multicast list Channel maps Assign -- done", err
 
-	case "vport": // DELETE vport <ID>
-		var vp nuage_v3_2.VPort
-		vp.ID = id
-		err := (&vp).Delete(myconn)
+	case "multicastchannelmap": // ASSIGN multicastchannelmap <Channel map ID> <VPort ID> [ send ]
+		if len(args) > 4 || (len(args) == 4 && args[3] != "send") {
+			return "Format:\n    ASSIGN multicastchannelmap <Channel map ID> <VPort ID> [ send ]", nil
+		}
+		vport := new(nuage_v3_2.VPort)
+		vport.ID = args[2]
+		err := vport.AssociateMulticastChannelMap(myconn, id, len(args) == 4)
 		if err != nil {
 			return "", err
 		}
-		return "", err
+		return "Multicast channel map Associate -- done", err
 
-	case "vminterface": // DELETE vminterface <ID>
-		var vmi nuage_v3_2.VMInterface
-		vmi.ID = id
-		err := (&vmi).Delete(myconn)
+	case "tier": // ASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>
+		if len(args) != 4 {
+			return "Format:\n    ASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>", nil
+		}
+		t := new(nuage_v3_2.Tier)
+		t.ID = id
+		err := t.Bind(myconn, args[2], args[3])
 		if err != nil {
 			return "", err
 		}
-		return "", err
+		return "Tier Bind -- done", err
 
-	case "vm": // DELETE vm <ID>
-		var vm nuage_v3_2.VirtualMachine
-		vm.ID = id
-		err := (&vm).Delete(myconn)
+	case "multinicvport": // ASSIGN multinicvport <Multi-NIC VPort ID> <VPort ID> [ <VPort ID> ... ]
+		mnv := new(nuage_v3_2.MultiNICVPort)
+		mnv.ID = id
+		err := mnv.AttachVPorts(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		return "", err
+		return "Multi-NIC VPort VPorts Attach -- done", err
 
 	default:
-		return "Don't know how to DELETE entity: " + entity, nil
+		return "Don't know how to ASSIGN to entity: " + entity, nil
 	}
-	return "", nil
 }
 
-func Create(args ...string) (string, error) {
-
-	// At least 2 arguments: entity <Name>
-
-	if len(args) < 2 {
-		return "Format:\n    CREATE <entity> <Name> [options]", nil
+// Member unassignment. Format: <group entity> <group ID> <member ID> [ <member ID> ... ]
+func Unassign(args ...string) (string, error) {
+	if len(args) < 3 {
+		return "Format:\n    UNASSIGN policygroup <Policy group ID> <VPort ID> [ <VPort ID> ... ]\n    UNASSIGN floatingip <Floating IP ID> <VPort ID>\n    UNASSIGN networkmacrogroup <Network macro group ID> <Network macro ID> [ <Network macro ID> ... ]\n    UNASSIGN group <Group ID> <User ID> [ <User ID> ... ]\n    UNASSIGN redirectiontarget <Redirection target ID> <VPort ID> [ <VPort ID> ... ]\n    UNASSIGN multicastlist <Multicast list ID> <Channel map ID> [ <Channel map ID> ... ]\n    UNASSIGN multicastchannelmap <Channel map ID> <VPort ID> [ send ]\n    UNASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>\n    UNASSIGN multinicvport <Multi-NIC VPort ID> <VPort ID> [ <VPort ID> ... ]", nil
 	}
 
 	entity := args[0]
+	id := args[1]
 
 	switch entity {
-	case "enterprise":
-		if len(args) != 2 {
-			return "Format:\n    CREATE enterprise <Name>", nil
+	case "policygroup": // UNASSIGN policygroup <Policy group ID> <VPort ID> [ <VPort ID> ... ]
+		pg := new(nuage_v3_2.PolicyGroup)
+		pg.ID = id
+		err := pg.UnassignVPorts(myconn, args[2:]...)
+		if err != nil {
+			return "", err
 		}
+		return "Policy group VPorts Unassign -- done", err
 
-		// CREATE enterprise <Name>
-		org := new(nuage_v3_2.Enterprise)
-		org.Name = args[1]
-		err := org.Create(myconn)
+	case "floatingip": // UNASSIGN floatingip <Floating IP ID> <VPort ID>
+		if len(args) != 3 {
+			return "Format:\n    UNASSIGN floatingip <Floating IP ID> <VPort ID>", nil
+		}
+		vport := new(nuage_v3_2.VPort)
+		vport.ID = args[2]
+		err := vport.Get(myconn)
 		if err != nil {
 			return "", err
 		}
+		if vport.AssociatedFloatingIPID != id {
+			return "Floating IP [" + id + "] is not associated with VPort [" + vport.ID + "]", nil
+		}
+		err = vport.DisassociateFloatingIP(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "Floating IP Disassociate -- done", err
 
-		// JSON pretty-print the org
-		jsonorg, _ := json.MarshalIndent(org, "", "\t")
-		fmt.Printf("\n ===> Org: [%s] <=== \n%#s\n", org.Name, string(jsonorg))
-		return "", err
-
-	case "domaintemplate":
-		if len(args) != 3 {
-			return "Format:\n    CREATE domaintemplate <Name> <Parent Enterprise ID> ", nil
+	case "networkmacrogroup": // UNASSIGN networkmacrogroup <Network macro group ID> <Network macro ID> [ <Network macro ID> ... ]
+		nmg := new(nuage_v3_2.NetworkMacroGroup)
+		nmg.ID = id
+		err := nmg.UnassignEnterpriseNetworks(myconn, args[2:]...)
+		if err != nil {
+			return "", err
 		}
+		return "Network macro group members Unassign -- done", err
 
-		// CREATE domaintemplate <Name> <Parent Enterprise ID>
-		dt := new(nuage_v3_2.Domaintemplate)
-		dt.Name = args[1]
-		dt.ParentID = args[2]
-		err := dt.Create(myconn)
+	case "group": // UNASSIGN group <Group ID> <User ID> [ <User ID> ... ]
+		g := new(nuage_v3_2.Group)
+		g.ID = id
+		err := g.RemoveUsers(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		// JSON pretty-print the domain template
-		jsondt, _ := json.MarshalIndent(dt, "", "\t")
-		fmt.Printf("\n ===> Domain Template: Name [%s] <=== \n%#s\n", dt.Name, string(jsondt))
-		return "Domain Template Create -- done", err
+		return "Group Users Remove -- done", err
 
-	case "domain":
-		if len(args) != 4 {
-			return "Format:\n    CREATE domain <Name> <Parent Enterprise ID> <Domain template ID>", nil
+	case "redirectiontarget": // UNASSIGN redirectiontarget <Redirection target ID> <VPort ID> [ <VPort ID> ... ]
+		rt := new(nuage_v3_2.RedirectionTarget)
+		rt.ID = id
+		err := rt.UnassignVPorts(myconn, args[2:]...)
+		if err != nil {
+			return "", err
 		}
-		// CREATE domain <Name> <Parent Enterprise ID> <Domain template ID>
-		domain := new(nuage_v3_2.Domain)
-		domain.Name = args[1]
-		domain.ParentID = args[2]
-		domain.TemplateID = args[3]
-		err := domain.Create(myconn)
+		return "Redirection target VPorts Unassign -- done", err
+
+	case "multicastlist": // UNASSIGN multicastlist <Multicast list ID> <Channel map ID> [ <Channel map ID> ... ]
+		ml := new(nuage_v3_2.MulticastList)
+		ml.ID = id
+		err := ml.UnassignChannelMaps(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		jsondomain, _ := json.MarshalIndent(domain, "", "\t")
-		fmt.Printf("\n ===> Domain Name [%s] <=== \n%#s\n", domain.Name, string(jsondomain))
-		return "Domain Create -- done", err
+		return "Multicast list Channel maps Unassign -- done", err
 
-	case "zonetemplate":
-		if len(args) != 3 {
-			return "Format:\n    CREATE zonetemplate <Name> <Parent domain template ID>", nil
+	case "multicastchannelmap": // UNASSIGN multicastchannelmap <Channel map ID> <VPort ID> [ send ]
+		if len(args) > 4 || (len(args) == 4 && args[3] != "send") {
+			return "Format:\n    UNASSIGN multicastchannelmap <Channel map ID> <VPort ID> [ send ]", nil
 		}
-		// CREATE zonetemplate <Name> <Parent domain template ID>
-		zt := new(nuage_v3_2.Zonetemplate)
-		zt.Name = args[1]
-		zt.ParentID = args[2]
-		err := zt.Create(myconn)
+		send := len(args) == 4
+		vport := new(nuage_v3_2.VPort)
+		vport.ID = args[2]
+		err := vport.Get(myconn)
 		if err != nil {
 			return "", err
 		}
-		jsonzt, _ := json.MarshalIndent(zt, "", "\t")
-		fmt.Printf("\n ===> Zone template: Name [%s] <=== \n%#s\n", zt.Name, string(jsonzt))
-		return "Zone Template Create -- done", err
-	case "zone":
-		if len(args) < 3 {
-			return "Format:\n    CREATE zone <Name> <Parent Domain ID> [ <Zone template ID> ]", nil
+		current := vport.AssociatedMulticastChannelMapID
+		if send {
+			current = vport.AssociatedSendMulticastChannelMapID
 		}
-		// CREATE zone <Name> <Parent Domain ID> [ <Zone template ID> ]
-		zone := new(nuage_v3_2.Zone)
-		zone.Name = args[1]
-		zone.ParentID = args[2]
-		if len(args) >= 4 {
-			zone.TemplateID = args[3]
+		if current != id {
+			return "Multicast channel map [" + id + "] is not associated with VPort [" + vport.ID + "]", nil
 		}
-		err := zone.Create(myconn)
+		err = vport.DisassociateMulticastChannelMap(myconn, send)
 		if err != nil {
 			return "", err
 		}
-		jsonzone, _ := json.MarshalIndent(zone, "", "\t")
-		fmt.Printf("\n ===> Zone Name [%s] <=== \n%#s\n", zone.Name, string(jsonzone))
-		return "Zone Create -- done", err
+		return "Multicast channel map Disassociate -- done", err
 
-	case "subnet":
-		if len(args) < 4 {
-			return "Format:\n    CREATE subnet <Name> <Parent Zone ID> <Subnet template ID> \n or:\n     CREATE subnet <Name> <Parent Zone ID> <Subnet address> <Subnet mask>\n", nil
+	case "tier": // UNASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>
+		if len(args) != 4 {
+			return "Format:\n    UNASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>", nil
 		}
-		switch len(args) {
-		case 4:
-			// CREATE subnet <Name> <Parent Subnet ID> <Subnet template ID>
-			subnet := new(nuage_v3_2.Subnet)
-			subnet.Name = args[1]
-			subnet.ParentID = args[2]
-			subnet.TemplateID = args[3]
-			err := subnet.Create(myconn)
-			if err != nil {
+		var bound string
+		switch args[2] {
+		case "zone":
+			zone := new(nuage_v3_2.Zone)
+			zone.ID = args[3]
+			if err := zone.Get(myconn); err != nil {
 				return "", err
 			}
-			jsonsubnet, _ := json.MarshalIndent(subnet, "", "\t")
-			fmt.Printf("\n ===> Subnet Name [%s] <=== \n%#s\n", subnet.Name, string(jsonsubnet))
-			return "Subnet Create -- done", err
-		case 5:
-			// CREATE subnet <Name> <Parent Subnet ID> <Subnet address> <Subnet mask>
+			bound = zone.AssociatedApplicationObjectID
+		case "subnet":
 			subnet := new(nuage_v3_2.Subnet)
-			subnet.Name = args[1]
-			subnet.ParentID = args[2]
-			// TBD -- make sure these are proper dot notation...
-			subnet.Address = args[3]
-			subnet.Netmask = args[4]
-			err := subnet.Create(myconn)
-			if err != nil {
+			subnet.ID = args[3]
+			if err := subnet.Get(myconn); err != nil {
 				return "", err
 			}
-			jsonsubnet, _ := json.MarshalIndent(subnet, "", "\t")
-			fmt.Printf("\n ===> Subnet Name [%s] <=== \n%#s\n", subnet.Name, string(jsonsubnet))
-			return "Subnet Create -- done", err
+			bound = subnet.AssociatedApplicationObjectID
+		default:
+			return "Format:\n    UNASSIGN tier <Tier ID> <zone | subnet> <Zone or Subnet ID>", nil
 		}
-
-	case "vport":
-		if len(args) < 3 {
-			return "Format:\n    CREATE vport <Name> <Parent Subnet ID> [ options ...]", nil
+		if bound != id {
+			return "Tier [" + id + "] is not bound to " + args[2] + " [" + args[3] + "]", nil
 		}
-		// CREATE vport <Name> <Parent Subnet ID> [ options ...]
-		subnet := new(nuage_v3_2.Subnet)
-		subnet.ID = args[2]
-
-		var vport nuage_v3_2.VPort
-		vport.Name = args[1]
-		// ???
-		vport.ID = vport.Name
-		vport.Type = "VM"
-		vport.AddressSpoofing = "INHERITED"
-
-		vport.Active = true
-		// ???? Not needed but still....
-		vport.ParentID = subnet.ID
-		vport.ParentType = "subnet"
-
-		jsonvport, err := json.MarshalIndent(vport, "", "\t")
-		fmt.Printf("\n ===> Created VPort: Name [%s] <=== \n%#s\n", vport.Name, string(jsonvport))
-
-		vp, err := subnet.AddVPort(myconn, vport)
-
+		err := nuage_v3_2.UnbindTier(myconn, args[2], args[3])
 		if err != nil {
 			return "", err
 		}
+		return "Tier Unbind -- done", err
 
-		jsonvp, err := json.MarshalIndent(vp, "", "\t")
-		fmt.Printf("\n ===> Created VPort: Name [%s] <=== \n%#s\n", vp.Name, string(jsonvp))
-
-		return "VPort Create -- done", err
-
-	case "vm":
-		if len(args) != 5 {
-			return "Format:\n    CREATE vm <Name> <UUID> <Interface0-MAC> <Interface0-VPortID>", nil
-		}
-		// CREATE vm <Name> <UUID> <Interface0-MAC> <Interface0-VPortID>
-		var vm nuage_v3_2.VirtualMachine
-		vm.Name = args[1]
-		vm.UUID = args[2]
-
-		var vmi nuage_v3_2.VMInterface
-		vmi.MAC = args[3]
-		vmi.VPortID = args[4]
-
-		vm.Interfaces = append(vm.Interfaces, vmi)
-
-		err := (&vm).Create(myconn)
+	case "multinicvport": // UNASSIGN multinicvport <Multi-NIC VPort ID> <VPort ID> [ <VPort ID> ... ]
+		mnv := new(nuage_v3_2.MultiNICVPort)
+		mnv.ID = id
+		err := mnv.DetachVPorts(myconn, args[2:]...)
 		if err != nil {
 			return "", err
 		}
-		jsonvm, _ := json.MarshalIndent(vm, "", "\t")
-		fmt.Printf("\n ===> Virtual Machine: Name [%s] <=== \n%#s\n", vm.Name, string(jsonvm))
-		return "Virtual Machine Create -- done", err
+		return "Multi-NIC VPort VPorts Detach -- done", err
 
 	default:
-		return "Don't know how to create Nuage API entity: [" + entity + "]" + " with Name [" + args[1] + "]" + " and options: " + strings.Join(args[2:], " "), nil
+		return "Don't know how to UNASSIGN from entity: " + entity, nil
 	}
-	return "", nil
 }
 
-func Get(args ...string) (string, error) {
-
-	// 1 argument:  <entity>
-	// 2 arguments: <entity> <ID>
-	// 3 arguments: <entity> <ID> <children>
+// Wait for an asynchronous VSD operation to finish, with a progress indicator. Format: job <Job ID> [ <Timeout, in seconds> ]
+func Wait(args ...string) (string, error) {
+	if len(args) < 2 || len(args) > 3 || args[0] != "job" {
+		return "Format:\n    wait job <Job ID> [ <Timeout, in seconds> ]", nil
+	}
 
-	if len(args) < 1 || len(args) > 3 {
-		return "GET <entity> [ <ID> [ <children> ] ]  ", nil
+	timeout := jobtimeout
+	if len(args) == 3 {
+		secs, err := strconv.Atoi(args[2])
+		if err != nil || secs <= 0 {
+			return "'" + args[2] + "'" + " is not a valid timeout", nil
+		}
+		timeout = time.Duration(secs) * time.Second
 	}
 
-	entity := args[0]
+	job := new(nuage_v3_2.Job)
+	job.ID = args[1]
+	err := job.Wait(myconn, timeout, printjobprogress)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
 
-	switch entity {
-	case "enterprises":
-		switch len(args) {
-		case 1: // GET enterprises
-			var orglist nuage_v3_2.EnterpriseSlice
-			err := orglist.List(myconn)
+	jsonjob, _ := json.MarshalIndent(job, "", "\t")
+	fmt.Printf("\n ===> Job: Command [%s] Status [%s] <=== \n%s\n", job.Command, job.Status, string(jsonjob))
+	return "Job Wait -- done", nil
+}
 
-			if err != nil {
-				return "", err
-			}
+// Traffic statistics. Format: <vport | subnet | zone | domain> <ID> [ --last <duration> ] [ --step <duration> ] [ --metrics <metric,...> ] [ --sparkline ]
+func Stats(args ...string) (string, error) {
+	format := "Format:\n    stats <vport | subnet | zone | domain> <ID> [ --last <duration, e.g. 15m> ] [ --step <duration, e.g. 1m> ] [ --metrics <metric>,... ] [ --sparkline ]"
+	if len(args) < 2 {
+		return format, nil
+	}
 
-			// Yuck -- We need to to this hacky type cast since we can iterate through "[]nuage_v3_2.Enterprise"  but not "nuage_v3_2.EnterpriseSlice"
-			var orgs []nuage_v3_2.Enterprise
-			orgs = orglist
+	last := 15 * time.Minute
+	var step time.Duration
+	var metrics []string
+	sparkline := false
 
-			// Iterate through the list of org's and JSON pretty-print them
-			for i, v := range orgs {
-				org, _ := json.MarshalIndent(v, "", "\t")
-				fmt.Printf("\n\n ===> Org nr [%d]: Name [%s] <=== \n%#s\n", i, orgs[i].Name, string(org))
+	for i := 2; i < len(args); i++ {
+		switch args[i] {
+		case "--sparkline":
+			sparkline = true
+			continue
+		case "--last", "--step", "--metrics":
+			if i+1 >= len(args) {
+				return format, nil
 			}
+		default:
+			return "Unknown option: [" + args[i] + "]\n" + format, nil
+		}
 
-			return "Enterprise list -- done", err
-
-		case 2: // GET enterprises <ID>
+		var err error
+		switch args[i] {
+		case "--last":
+			last, err = time.ParseDuration(args[i+1])
+		case "--step":
+			step, err = time.ParseDuration(args[i+1])
+		case "--metrics":
+			metrics = strings.Split(strings.ToUpper(args[i+1]), ",")
+		}
+		if err != nil {
+			return "'" + args[i+1] + "'" + " is not a valid duration, e.g. 15m, 1h", nil
+		}
+		i++
+	}
 
-			org := new(nuage_v3_2.Enterprise)
-			org.ID = args[1]
-			err := org.Get(myconn)
-			if err != nil {
-				return "", err
-			}
+	// By default: 15 data points
+	if step == 0 {
+		step = last / 15
+	}
 
-			// JSON pretty-print the org
-			jsonorg, _ := json.MarshalIndent(org, "", "\t")
-			fmt.Printf("\n\n ===> Org: Name [%s] <=== \n%#s\n", org.Name, string(jsonorg))
+	q := nuage_v3_2.StatisticsQuery{
+		Metrics:     metrics,
+		Start:       time.Now().Add(-last),
+		End:         time.Now(),
+		Granularity: step,
+	}
 
-			return "Enterprise Get ID -- done", err
+	var st nuage_v3_2.Statistics
+	var err error
 
-		case 3:
-			entityid := args[1]
+	switch args[0] {
+	case "vport":
+		vport := new(nuage_v3_2.VPort)
+		vport.ID = args[1]
+		st, err = vport.Statistics(myconn, q)
+	case "subnet":
+		subnet := new(nuage_v3_2.Subnet)
+		subnet.ID = args[1]
+		st, err = subnet.Statistics(myconn, q)
+	case "zone":
+		zone := new(nuage_v3_2.Zone)
+		zone.ID = args[1]
+		st, err = zone.Statistics(myconn, q)
+	case "domain":
+		domain := new(nuage_v3_2.Domain)
+		domain.ID = args[1]
+		st, err = domain.Statistics(myconn, q)
+	default:
+		return "Don't know how to get statistics for entity: " + args[0], nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Printf("\n ######## Statistics for %s ID: [%s] -- last %s, every %s ########\n\n", args[0], args[1], last, step)
+	if sparkline {
+		printsparklines(st)
+	} else {
+		printstatstable(st)
+	}
+
+	return "Statistics -- done", nil
+}
+
+// VirtualMachine resync. Format: <vm | hypervisor> <VM ID | Hypervisor IP> [ --wait ]
+func Resync(args ...string) (string, error) {
+	format := "Format:\n    resync vm <VirtualMachine ID> [ --wait [ <Timeout, in seconds> ] ]\n    resync hypervisor <Hypervisor IP> [ --wait [ <Timeout, in seconds> ] ]        ### All the VirtualMachines on that hypervisor"
+
+	if len(args) < 2 || len(args) > 4 || (len(args) >= 3 && args[2] != "--wait") {
+		return format, nil
+	}
+	wait := len(args) >= 3
+
+	timeout := resynctimeout
+	if len(args) == 4 {
+		secs, err := strconv.Atoi(args[3])
+		if err != nil || secs <= 0 {
+			return "'" + args[3] + "'" + " is not a valid timeout", nil
+		}
+		timeout = time.Duration(secs) * time.Second
+	}
+
+	var vms []nuage_v3_2.VirtualMachine
+
+	switch args[0] {
+	case "vm": // resync vm <VirtualMachine ID> [ --wait ]
+		vm := new(nuage_v3_2.VirtualMachine)
+		vm.ID = args[1]
+		err := vm.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		// As prior to the resync (see "WaitResync")
+		vms = append(vms, *vm)
+		err = vm.Resync(myconn)
+		if err != nil {
+			return "", err
+		}
+	case "hypervisor": // resync hypervisor <Hypervisor IP> [ --wait ]
+		var err error
+		vms, err = nuage_v3_2.ResyncHypervisor(myconn, args[1])
+		if err != nil {
+			// Some VMs may have been resynced nonetheless
+			if len(vms) == 0 {
+				return "", err
+			}
+			fmt.Printf("\n %s\n", err)
+		}
+	default:
+		return format, nil
+	}
+
+	fmt.Printf("\n Resync triggered for [%d] VirtualMachines\n", len(vms))
+
+	if !wait {
+		return "Resync -- done", nil
+	}
+
+	failed := 0
+	for _, vm := range vms {
+		fmt.Printf("\n VirtualMachine [%s] ID [%s]\n", vm.Name, vm.ID)
+		err := vm.WaitResync(myconn, vm.ResyncInfo.LastRequestTimestamp, timeout, printresyncprogress)
+		fmt.Println()
+		if err != nil {
+			fmt.Printf("  %s\n", err)
+			failed++
+		}
+	}
+
+	return fmt.Sprintf("Resync Wait (%d VirtualMachines, %d failed) -- done", len(vms), failed), nil
+}
+
+// Draft mode policy (ACL) editing. Format: <begin | apply | discard | status> [ domaintemplate ] <ID>
+func Policy(args ...string) (string, error) {
+	format := "Format:\n    policy <begin | apply | discard | status> <Domain ID>\n    policy <begin | apply | discard | status> domaintemplate <Domain template ID>"
+
+	var parenttype, id string
+	switch {
+	case len(args) == 2:
+		parenttype, id = "domain", args[1]
+	case len(args) == 3 && args[1] == "domaintemplate":
+		parenttype, id = "domaintemplate", args[2]
+	default:
+		return format, nil
+	}
+
+	d := new(nuage_v3_2.Domain)
+	d.ID = id
+	dt := new(nuage_v3_2.Domaintemplate)
+	dt.ID = id
+
+	var err error
+	switch args[0] {
+	case "begin":
+		if parenttype == "domain" {
+			err = d.BeginPolicyChanges(myconn)
+		} else {
+			err = dt.BeginPolicyChanges(myconn)
+		}
+	case "apply":
+		if parenttype == "domain" {
+			err = d.ApplyPolicyChanges(myconn)
+		} else {
+			err = dt.ApplyPolicyChanges(myconn)
+		}
+	case "discard":
+		if parenttype == "domain" {
+			err = d.DiscardPolicyChanges(myconn)
+		} else {
+			err = dt.DiscardPolicyChanges(myconn)
+		}
+	case "status":
+	default:
+		return format, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Show the current status, whatever the operation
+	var name, status string
+	if parenttype == "domain" {
+		err = d.Get(myconn)
+		name, status = d.Name, d.PolicyChangeStatus
+	} else {
+		err = dt.Get(myconn)
+		name, status = dt.Name, dt.PolicyChangeStatus
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if status == "" {
+		status = "none"
+	}
+	fmt.Printf("\n ===> %s: Name [%s] ID [%s] Policy change status: [%s] <=== \n", parenttype, name, id, status)
+	return "Policy " + args[0] + " -- done", nil
+}
+
+// Alarms, most recent first. Format: [ <entity> <ID> ] [ --severity <Severity> ] -- all Enterprises if no entity. Or: ack <Alarm ID> [ <Alarm ID> ... ]
+func Alarms(args ...string) (string, error) {
+	format := "Format:\n    alarms [ <enterprise | domain | l2domain | vrs | vport> <ID> ] [ --severity <" + strings.Join(nuage_v3_2.AlarmSeverities, " | ") + "> ]\n    alarms ack <Alarm ID> [ <Alarm ID> ... ]"
+
+	if len(args) > 0 && args[0] == "ack" {
+		if len(args) < 2 {
+			return format, nil
+		}
+		for _, id := range args[1:] {
+			a := new(nuage_v3_2.Alarm)
+			a.ID = id
+			if err := a.Acknowledge(myconn); err != nil {
+				return "", err
+			}
+		}
+		return "Alarm Acknowledge -- done", nil
+	}
+
+	var severity string
+	if n := len(args); n >= 2 && args[n-2] == "--severity" {
+		severity = args[n-1]
+		args = args[:n-2]
+	}
+
+	var alarms nuage_v3_2.Alarmslice
+
+	switch len(args) {
+	case 0: // alarms -- for all Enterprises
+		var orgs nuage_v3_2.EnterpriseSlice
+		err := orgs.List(myconn)
+		if err != nil {
+			return "", err
+		}
+		for _, org := range orgs {
+			var as nuage_v3_2.Alarmslice
+			if err := as.List(myconn, "enterprise", org.ID); err != nil {
+				return "", err
+			}
+			alarms = append(alarms, as...)
+		}
+	case 2: // alarms <entity> <ID>
+		err := alarms.List(myconn, args[0], args[1])
+		if err != nil {
+			return "", err
+		}
+	default:
+		return format, nil
+	}
+
+	alarms, err := alarms.FilterSeverity(severity)
+	if err != nil {
+		return "", err
+	}
+
+	sort.Sort(alarmsbytime(alarms))
+	printalarms(alarms)
+	return fmt.Sprintf("Alarm list (%d alarms) -- done", len(alarms)), nil
+}
+
+// Event logs (audit trail), most recent first. Format: <entity> <ID>
+func Events(args ...string) (string, error) {
+	if len(args) != 2 {
+		return "Format:\n    events <enterprise | domain | l2domain | vrs | vport> <ID>", nil
+	}
+
+	var events nuage_v3_2.EventLogslice
+	err := events.List(myconn, args[0], args[1])
+	if err != nil {
+		return "", err
+	}
+
+	sort.Sort(eventsbytime(events))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "\nTIME\tTYPE\tENTITY\tENTITY ID\tUSER")
+	for _, e := range events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Time().Format("2006-01-02 15:04:05"), e.Type, e.EntityType, e.EntityID, e.User)
+	}
+	w.Flush()
+
+	return fmt.Sprintf("Event log list (%d events) -- done", len(events)), nil
+}
+
+// Tag an entity with key = value pairs, or list its tags. Format: <entities> <ID> [ <key>=<value> ... ] . Entities in plural, as for GET, e.g. "domains"
+func Tag(args ...string) (string, error) {
+	if len(args) < 2 {
+		return "Format:\n    tag <entities, e.g. domains> <ID> [ <key>=<value> ... ]", nil
+	}
+
+	entity, found := taggable[args[0]]
+	if !found {
+		return "Don't know how to tag Nuage API entities: [" + args[0] + "]. Known: " + taggablelist(), nil
+	}
+	id := args[1]
+
+	for _, kv := range args[2:] {
+		i := strings.Index(kv, "=")
+		if i <= 0 {
+			return "'" + kv + "'" + " is not a valid tag, expecting <key>=<value>", nil
+		}
+		if err := nuage_v3_2.Tag(myconn, entity, id, kv[:i], kv[i+1:]); err != nil {
+			return "", err
+		}
+	}
+
+	mds, err := nuage_v3_2.Tags(myconn, entity, id)
+	if err != nil {
+		return "", err
+	}
+	printtags(mds)
+
+	if len(args) == 2 {
+		return "Tag list -- done", nil
+	}
+	return "Tag -- done", nil
+}
+
+// Remove tags from an entity. Format: <entities> <ID> <key> [ <key> ... ] . Entities in plural, as for GET, e.g. "domains"
+func Untag(args ...string) (string, error) {
+	if len(args) < 3 {
+		return "Format:\n    untag <entities, e.g. domains> <ID> <key> [ <key> ... ]", nil
+	}
+
+	entity, found := taggable[args[0]]
+	if !found {
+		return "Don't know how to untag Nuage API entities: [" + args[0] + "]. Known: " + taggablelist(), nil
+	}
+
+	for _, key := range args[2:] {
+		if err := nuage_v3_2.Untag(myconn, entity, args[1], key); err != nil {
+			return "", err
+		}
+	}
+
+	return "Untag -- done", nil
+}
+
+func Delete(args ...string) (string, error) {
+	// Format: <entity> <ID>
+	if len(args) != 2 {
+		return "Format:\n    DELETE <entity> <ID>", nil
+	}
+	entity := args[0]
+	id := args[1]
+	switch entity {
+	case "enterprise": // DELETE enterprise <ID>
+		org := new(nuage_v3_2.Enterprise)
+		org.ID = id
+		err := apidelete(org)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+	case "domaintemplate": // DELETE domaintemplate <ID>
+		dt := new(nuage_v3_2.Domaintemplate)
+		dt.ID = id
+		err := apidelete(dt)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+	case "domain": // DELETE domain <ID>
+		domain := new(nuage_v3_2.Domain)
+		domain.ID = id
+		err := apidelete(domain)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+	case "l2domaintemplate": // DELETE l2domaintemplate <ID>
+		l2dt := new(nuage_v3_2.L2Domaintemplate)
+		l2dt.ID = id
+		err := l2dt.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+	case "l2domain": // DELETE l2domain <ID>
+		l2domain := new(nuage_v3_2.L2Domain)
+		l2domain.ID = id
+		err := l2domain.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+	case "zonetemplate": // DELETE zonetemplate <ID>
+		zt := new(nuage_v3_2.Zonetemplate)
+		zt.ID = id
+		err := apidelete(zt)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "subnettemplate": // DELETE subnettemplate <ID>
+		st := new(nuage_v3_2.Subnettemplate)
+		st.ID = id
+		err := st.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "zone": // DELETE zone <ID>
+		zone := new(nuage_v3_2.Zone)
+		zone.ID = id
+		err := apidelete(zone)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "subnet": // DELETE subnet <ID>
+		subnet := new(nuage_v3_2.Subnet)
+		subnet.ID = id
+		err := apidelete(subnet)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "multinicvport": // DELETE multinicvport <ID>
+		mnv := new(nuage_v3_2.MultiNICVPort)
+		mnv.ID = id
+		err := mnv.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "application": // DELETE application <ID>
+		app := new(nuage_v3_2.Application)
+		app.ID = id
+		err := app.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "tier": // DELETE tier <ID>
+		t := new(nuage_v3_2.Tier)
+		t.ID = id
+		err := t.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "flow": // DELETE flow <ID>
+		f := new(nuage_v3_2.Flow)
+		f.ID = id
+		err := f.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "applicationservice": // DELETE applicationservice <ID>
+		as := new(nuage_v3_2.ApplicationService)
+		as.ID = id
+		err := as.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "redirectiontarget": // DELETE redirectiontarget <ID>
+		rt := new(nuage_v3_2.RedirectionTarget)
+		rt.ID = id
+		err := rt.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "virtualip": // DELETE virtualip <ID>
+		vip := new(nuage_v3_2.VirtualIP)
+		vip.ID = id
+		err := vip.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "hostinterface": // DELETE hostinterface <ID>
+		hi := new(nuage_v3_2.HostInterface)
+		hi.ID = id
+		err := hi.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "bridgeinterface": // DELETE bridgeinterface <ID>
+		bi := new(nuage_v3_2.BridgeInterface)
+		bi.ID = id
+		err := bi.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "vlan": // DELETE vlan <ID>
+		vlan := new(nuage_v3_2.VLAN)
+		vlan.ID = id
+		err := vlan.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "dhcpoption": // DELETE dhcpoption <ID>
+		o := new(nuage_v3_2.DHCPOption)
+		o.ID = id
+		err := o.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "multicastchannelmap": // DELETE multicastchannelmap <ID>
+		cm := new(nuage_v3_2.MulticastChannelMap)
+		cm.ID = id
+		err := cm.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "multicastrange": // DELETE multicastrange <ID>
+		mr := new(nuage_v3_2.MulticastRange)
+		mr.ID = id
+		err := mr.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "multicastlist": // DELETE multicastlist <ID>
+		ml := new(nuage_v3_2.MulticastList)
+		ml.ID = id
+		err := ml.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "qos": // DELETE qos <ID>
+		q := new(nuage_v3_2.QoS)
+		q.ID = id
+		err := q.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "ratelimiter": // DELETE ratelimiter <ID>
+		rl := new(nuage_v3_2.RateLimiter)
+		rl.ID = id
+		err := rl.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "user": // DELETE user <ID>
+		u := new(nuage_v3_2.User)
+		u.ID = id
+		err := u.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "group": // DELETE group <ID>
+		g := new(nuage_v3_2.Group)
+		g.ID = id
+		err := g.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "networkmacro": // DELETE networkmacro <ID>
+		en := new(nuage_v3_2.EnterpriseNetwork)
+		en.ID = id
+		err := en.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "networkmacrogroup": // DELETE networkmacrogroup <ID>
+		nmg := new(nuage_v3_2.NetworkMacroGroup)
+		nmg.ID = id
+		err := nmg.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "staticroute": // DELETE staticroute <ID>
+		sr := new(nuage_v3_2.StaticRoute)
+		sr.ID = id
+		err := sr.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "fippool": // DELETE fippool <ID>
+		pool := new(nuage_v3_2.SharedNetworkResource)
+		pool.ID = id
+		err := pool.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "floatingip": // DELETE floatingip <ID> -- i.e. release it back to its FIP pool
+		fip := new(nuage_v3_2.FloatingIP)
+		fip.ID = id
+		err := fip.Release(myconn)
+		if err != nil {
+			return "", err
+		}
+		err = printfipquota(fip.ParentID)
+		return "Floating IP Release -- done", err
+
+	case "policygroup": // DELETE policygroup <ID>
+		pg := new(nuage_v3_2.PolicyGroup)
+		pg.ID = id
+		err := pg.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "policygrouptemplate": // DELETE policygrouptemplate <ID>
+		pgt := new(nuage_v3_2.PolicyGrouptemplate)
+		pgt.ID = id
+		err := pgt.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "ingressacltemplate": // DELETE ingressacltemplate <ID>
+		acl := new(nuage_v3_2.IngressACLTemplate)
+		acl.ID = id
+		err := acl.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "ingressaclentry": // DELETE ingressaclentry <ID>
+		entry := new(nuage_v3_2.IngressACLEntry)
+		entry.ID = id
+		err := entry.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "egressacltemplate": // DELETE egressacltemplate <ID>
+		acl := new(nuage_v3_2.EgressACLTemplate)
+		acl.ID = id
+		err := acl.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "egressaclentry": // DELETE egressaclentry <ID>
+		entry := new(nuage_v3_2.EgressACLEntry)
+		entry.ID = id
+		err := entry.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "ingressadvfwdtemplate": // DELETE ingressadvfwdtemplate <ID>
+		afwd := new(nuage_v3_2.IngressAdvFwdTemplate)
+		afwd.ID = id
+		err := afwd.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "ingressadvfwdentry": // DELETE ingressadvfwdentry <ID>
+		entry := new(nuage_v3_2.IngressAdvFwdEntry)
+		entry.ID = id
+		err := entry.Delete(myconn)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "vport": // DELETE vport <ID>
+		var vp nuage_v3_2.VPort
+		vp.ID = id
+		err := apidelete(&vp)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "vminterface": // DELETE vminterface <ID>
+		var vmi nuage_v3_2.VMInterface
+		vmi.ID = id
+		err := apidelete(&vmi)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	case "vm": // DELETE vm <ID>
+		var vm nuage_v3_2.VirtualMachine
+		vm.ID = id
+		err := apidelete(&vm)
+		if err != nil {
+			return "", err
+		}
+		return "", err
+
+	default:
+		return "Don't know how to DELETE entity: " + entity, nil
+	}
+	return "", nil
+}
+
+func Create(args ...string) (string, error) {
+	// At least 2 arguments: entity <Name>
+
+	if len(args) < 2 {
+		return "Format:\n    CREATE <entity> <Name> [options]", nil
+	}
+
+	entity := args[0]
+
+	switch entity {
+	case "enterprise":
+		if len(args) != 2 {
+			return "Format:\n    CREATE enterprise <Name>", nil
+		}
+
+		// CREATE enterprise <Name>
+		org := new(nuage_v3_2.Enterprise)
+		org.Name = args[1]
+		shown, err := apicreate(org)
+		if err != nil {
+			return "", err
+		}
+
+		// JSON pretty-print the org
+		jsonorg, _ := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Org: [%s] <=== \n%#s\n", org.Name, string(jsonorg))
+		return "", err
+
+	case "domaintemplate":
+		if len(args) != 3 {
+			return "Format:\n    CREATE domaintemplate <Name> <Parent Enterprise ID> ", nil
+		}
+
+		// CREATE domaintemplate <Name> <Parent Enterprise ID>
+		dt := new(nuage_v3_2.Domaintemplate)
+		dt.Name = args[1]
+		dt.ParentID = args[2]
+		shown, err := apicreate(dt)
+		if err != nil {
+			return "", err
+		}
+		// JSON pretty-print the domain template
+		jsondt, _ := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Domain Template: Name [%s] <=== \n%#s\n", dt.Name, string(jsondt))
+		return "Domain Template Create -- done", err
+
+	case "domain":
+		if len(args) != 4 {
+			return "Format:\n    CREATE domain <Name> <Parent Enterprise ID> <Domain template ID>", nil
+		}
+		// CREATE domain <Name> <Parent Enterprise ID> <Domain template ID>
+		domain := new(nuage_v3_2.Domain)
+		domain.Name = args[1]
+		domain.ParentID = args[2]
+		domain.TemplateID = args[3]
+		shown, err := apicreate(domain)
+		if err != nil {
+			return "", err
+		}
+		jsondomain, _ := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Domain Name [%s] <=== \n%#s\n", domain.Name, string(jsondomain))
+		return "Domain Create -- done", err
+
+	case "l2domaintemplate":
+		if len(args) != 3 && len(args) != 5 && len(args) != 6 {
+			return "Format:\n    CREATE l2domaintemplate <Name> <Parent Enterprise ID>  (unmanaged -- no DHCP)\n or:\n    CREATE l2domaintemplate <Name> <Parent Enterprise ID> <Address> <Netmask> [ <Gateway> ]  (DHCP managed)", nil
+		}
+		// CREATE l2domaintemplate <Name> <Parent Enterprise ID> [ <Address> <Netmask> [ <Gateway> ] ]
+		l2dt := new(nuage_v3_2.L2Domaintemplate)
+		l2dt.Name = args[1]
+		l2dt.ParentID = args[2]
+		if len(args) >= 5 {
+			if net.ParseIP(args[3]) == nil || net.ParseIP(args[4]) == nil {
+				return "Invalid Address / Netmask: " + args[3] + " / " + args[4], nil
+			}
+			l2dt.DHCPManaged = true
+			l2dt.Address = args[3]
+			l2dt.Netmask = args[4]
+		}
+		if len(args) == 6 {
+			if net.ParseIP(args[5]) == nil {
+				return "Invalid Gateway: " + args[5], nil
+			}
+			l2dt.Gateway = args[5]
+		}
+		err := l2dt.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonl2dt, _ := json.MarshalIndent(l2dt, "", "\t")
+		fmt.Printf("\n ===> L2 Domain Template: Name [%s] <=== \n%s\n", l2dt.Name, string(jsonl2dt))
+		return "L2 Domain Template Create -- done", err
+
+	case "l2domain":
+		if len(args) != 4 {
+			return "Format:\n    CREATE l2domain <Name> <Parent Enterprise ID> <L2 Domain template ID>", nil
+		}
+		// CREATE l2domain <Name> <Parent Enterprise ID> <L2 Domain template ID>
+		l2domain := new(nuage_v3_2.L2Domain)
+		l2domain.Name = args[1]
+		l2domain.ParentID = args[2]
+		l2domain.TemplateID = args[3]
+		err := l2domain.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonl2domain, _ := json.MarshalIndent(l2domain, "", "\t")
+		fmt.Printf("\n ===> L2 Domain Name [%s] <=== \n%s\n", l2domain.Name, string(jsonl2domain))
+		return "L2 Domain Create -- done", err
+
+	case "zonetemplate":
+		if len(args) != 3 {
+			return "Format:\n    CREATE zonetemplate <Name> <Parent domain template ID>", nil
+		}
+		// CREATE zonetemplate <Name> <Parent domain template ID>
+		zt := new(nuage_v3_2.Zonetemplate)
+		zt.Name = args[1]
+		zt.ParentID = args[2]
+		shown, err := apicreate(zt)
+		if err != nil {
+			return "", err
+		}
+		jsonzt, _ := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Zone template: Name [%s] <=== \n%#s\n", zt.Name, string(jsonzt))
+		return "Zone Template Create -- done", err
+	case "subnettemplate":
+		if len(args) != 5 {
+			return "Format:\n    CREATE subnettemplate <Name> <Parent zone template ID> <Address> <Netmask>", nil
+		}
+		// CREATE subnettemplate <Name> <Parent zone template ID> <Address> <Netmask>
+		if net.ParseIP(args[3]) == nil || net.ParseIP(args[4]) == nil {
+			return "Invalid Address / Netmask: " + args[3] + " / " + args[4], nil
+		}
+		st := new(nuage_v3_2.Subnettemplate)
+		st.Name = args[1]
+		st.ParentID = args[2]
+		st.Address = args[3]
+		st.Netmask = args[4]
+		err := st.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonst, _ := json.MarshalIndent(st, "", "\t")
+		fmt.Printf("\n ===> Subnet template: Name [%s] <=== \n%s\n", st.Name, string(jsonst))
+		return "Subnet Template Create -- done", err
+	case "zone":
+		if len(args) < 3 {
+			return "Format:\n    CREATE zone <Name> <Parent Domain ID> [ <Zone template ID> ]", nil
+		}
+		// CREATE zone <Name> <Parent Domain ID> [ <Zone template ID> ]
+		zone := new(nuage_v3_2.Zone)
+		zone.Name = args[1]
+		zone.ParentID = args[2]
+		if len(args) >= 4 {
+			zone.TemplateID = args[3]
+		}
+		shown, err := apicreate(zone)
+		if err != nil {
+			return "", err
+		}
+		jsonzone, _ := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Zone Name [%s] <=== \n%#s\n", zone.Name, string(jsonzone))
+		return "Zone Create -- done", err
+
+	case "subnet":
+		if len(args) < 4 {
+			return "Format:\n    CREATE subnet <Name> <Parent Zone ID> <Subnet template ID> \n or:\n     CREATE subnet <Name> <Parent Zone ID> <Subnet address> <Subnet mask>\n", nil
+		}
+		switch len(args) {
+		case 4:
+			// CREATE subnet <Name> <Parent Subnet ID> <Subnet template ID>
+			subnet := new(nuage_v3_2.Subnet)
+			subnet.Name = args[1]
+			subnet.ParentID = args[2]
+			subnet.TemplateID = args[3]
+			shown, err := apicreate(subnet)
+			if err != nil {
+				return "", err
+			}
+			jsonsubnet, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Subnet Name [%s] <=== \n%#s\n", subnet.Name, string(jsonsubnet))
+			return "Subnet Create -- done", err
+		case 5:
+			// CREATE subnet <Name> <Parent Subnet ID> <Subnet address> <Subnet mask>
+			subnet := new(nuage_v3_2.Subnet)
+			subnet.Name = args[1]
+			subnet.ParentID = args[2]
+			// TBD -- make sure these are proper dot notation...
+			subnet.Address = args[3]
+			subnet.Netmask = args[4]
+			shown, err := apicreate(subnet)
+			if err != nil {
+				return "", err
+			}
+			jsonsubnet, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Subnet Name [%s] <=== \n%#s\n", subnet.Name, string(jsonsubnet))
+			return "Subnet Create -- done", err
+		}
+
+	case "job":
+		if len(args) != 4 {
+			return "Format:\n    CREATE job <Parent type> <Parent ID> <Command>", nil
+		}
+		// CREATE job <Parent type> <Parent ID> <Command> -- Submit only. Use "wait job <ID>" to follow its progress
+		job := new(nuage_v3_2.Job)
+		job.ParentType = args[1]
+		job.ParentID = args[2]
+		job.Command = args[3]
+		err := job.Submit(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonjob, _ := json.MarshalIndent(job, "", "\t")
+		fmt.Printf("\n ===> Job: Command [%s] Status [%s] <=== \n%s\n", job.Command, job.Status, string(jsonjob))
+		return "Job Submit -- done", err
+
+	case "multinicvport":
+		if len(args) != 3 {
+			return "Format:\n    CREATE multinicvport <Name> <Parent Enterprise ID>", nil
+		}
+		// CREATE multinicvport <Name> <Parent Enterprise ID>
+		mnv := new(nuage_v3_2.MultiNICVPort)
+		mnv.Name = args[1]
+		mnv.ParentID = args[2]
+		err := mnv.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonmnv, _ := json.MarshalIndent(mnv, "", "\t")
+		fmt.Printf("\n ===> Multi-NIC VPort: Name [%s] <=== \n%s\n", mnv.Name, string(jsonmnv))
+		return "Multi-NIC VPort Create -- done", err
+
+	case "application":
+		if len(args) != 3 {
+			return "Format:\n    CREATE application <Name> <Parent Domain ID>", nil
+		}
+		// CREATE application <Name> <Parent Domain ID>
+		app := new(nuage_v3_2.Application)
+		app.Name = args[1]
+		app.ParentID = args[2]
+		err := app.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonapp, _ := json.MarshalIndent(app, "", "\t")
+		fmt.Printf("\n ===> Application: Name [%s] <=== \n%s\n", app.Name, string(jsonapp))
+		return "Application Create -- done", err
+
+	case "tier":
+		format := "Format:\n    CREATE tier <Name> <Parent Application ID> [ <Address> <Netmask> [ <Gateway> ] ]\n    CREATE tier <Name> <Parent Application ID> networkmacro=<Network macro ID>"
+		if len(args) < 3 || len(args) > 6 {
+			return format, nil
+		}
+		t := new(nuage_v3_2.Tier)
+		t.Name = args[1]
+		t.ParentID = args[2]
+		switch len(args) {
+		case 3: // CREATE tier <Name> <Parent Application ID>
+		case 4: // CREATE tier <Name> <Parent Application ID> networkmacro=<Network macro ID>
+			if !strings.HasPrefix(args[3], "networkmacro=") {
+				return format, nil
+			}
+			t.Type = "NETWORK_MACRO"
+			t.AssociatedNetworkMacroID = strings.TrimPrefix(args[3], "networkmacro=")
+		default: // CREATE tier <Name> <Parent Application ID> <Address> <Netmask> [ <Gateway> ]
+			t.Address = args[3]
+			t.Netmask = args[4]
+			if len(args) == 6 {
+				t.Gateway = args[5]
+			}
+		}
+		err := t.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsontier, _ := json.MarshalIndent(t, "", "\t")
+		fmt.Printf("\n ===> Tier: Name [%s] <=== \n%s\n", t.Name, string(jsontier))
+		return "Tier Create -- done", err
+
+	case "flow":
+		if len(args) != 5 && len(args) != 6 {
+			return "Format:\n    CREATE flow <Name> <Parent Application ID> <Origin Tier ID> <Destination Tier ID> [ <Application service ID> ]", nil
+		}
+		// CREATE flow <Name> <Parent Application ID> <Origin Tier ID> <Destination Tier ID> [ <Application service ID> ]
+		f := new(nuage_v3_2.Flow)
+		f.Name = args[1]
+		f.ParentID = args[2]
+		f.OriginTierID = args[3]
+		f.DestinationTierID = args[4]
+		if len(args) == 6 {
+			f.AssociatedApplicationServiceID = args[5]
+		}
+		err := f.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonflow, _ := json.MarshalIndent(f, "", "\t")
+		fmt.Printf("\n ===> Flow: Name [%s] <=== \n%s\n", f.Name, string(jsonflow))
+		return "Flow Create -- done", err
+
+	case "applicationservice":
+		if len(args) < 5 || len(args) > 7 {
+			return "Format:\n    CREATE applicationservice <Name> <enterprise | domain> <Parent ID> <tcp | udp | icmp | any | number> [ <Destination port> [ <Source port> ] ]", nil
+		}
+		// CREATE applicationservice <Name> <enterprise | domain> <Parent ID> <Protocol> [ <Destination port> [ <Source port> ] ]
+		as := new(nuage_v3_2.ApplicationService)
+		as.Name = args[1]
+		as.ParentType = args[2]
+		as.ParentID = args[3]
+		as.Protocol = toprotocol(args[4])
+		if len(args) >= 6 {
+			as.DestinationPort = args[5]
+		}
+		if len(args) == 7 {
+			as.SourcePort = args[6]
+		}
+		err := as.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonas, _ := json.MarshalIndent(as, "", "\t")
+		fmt.Printf("\n ===> Application service: Name [%s] <=== \n%s\n", as.Name, string(jsonas))
+		return "Application service Create -- done", err
+
+	case "redirectiontarget":
+		if len(args) != 4 && len(args) != 5 {
+			return "Format:\n    CREATE redirectiontarget <Name> <domain | l2domain> <Parent ID> [ <L3 | VIRTUAL_WIRE> ]", nil
+		}
+		// CREATE redirectiontarget <Name> <domain | l2domain> <Parent ID> [ <L3 | VIRTUAL_WIRE> ]
+		rt := new(nuage_v3_2.RedirectionTarget)
+		rt.Name = args[1]
+		rt.ParentType = args[2]
+		rt.ParentID = args[3]
+		if len(args) == 5 {
+			rt.EndPointType = args[4]
+		}
+		err := rt.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonrt, _ := json.MarshalIndent(rt, "", "\t")
+		fmt.Printf("\n ===> Redirection target: Name [%s] <=== \n%s\n", rt.Name, string(jsonrt))
+		return "Redirection target Create -- done", err
+
+	case "virtualip":
+		if len(args) != 3 && len(args) != 4 {
+			return "Format:\n    CREATE virtualip <Parent VPort ID> <IP address> [ <MAC> ]", nil
+		}
+		// CREATE virtualip <Parent VPort ID> <IP address> [ <MAC> ]
+		vip := new(nuage_v3_2.VirtualIP)
+		vip.ParentID = args[1]
+		vip.VirtualIP = args[2]
+		if len(args) == 4 {
+			vip.MAC = args[3]
+		}
+		err := vip.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonvip, _ := json.MarshalIndent(vip, "", "\t")
+		fmt.Printf("\n ===> Virtual IP: Address [%s] <=== \n%s\n", vip.VirtualIP, string(jsonvip))
+		return "Virtual IP Create -- done", err
+
+	case "hostinterface":
+		if len(args) != 4 && len(args) != 5 {
+			return "Format:\n    CREATE hostinterface <Name> <Parent VPort ID> <MAC> [ <IP address> ]", nil
+		}
+		// CREATE hostinterface <Name> <Parent VPort ID> <MAC> [ <IP address> ]
+		hi := new(nuage_v3_2.HostInterface)
+		hi.Name = args[1]
+		hi.ParentID = args[2]
+		hi.MAC = args[3]
+		if len(args) == 5 {
+			hi.IPAddress = args[4]
+		}
+		err := hi.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonhost, _ := json.MarshalIndent(hi, "", "\t")
+		fmt.Printf("\n ===> Host interface: Name [%s] <=== \n%s\n", hi.Name, string(jsonhost))
+		return "Host interface Create -- done", err
+
+	case "bridgeinterface":
+		if len(args) != 3 {
+			return "Format:\n    CREATE bridgeinterface <Name> <Parent VPort ID>", nil
+		}
+		// CREATE bridgeinterface <Name> <Parent VPort ID>
+		bi := new(nuage_v3_2.BridgeInterface)
+		bi.Name = args[1]
+		bi.ParentID = args[2]
+		err := bi.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonbridge, _ := json.MarshalIndent(bi, "", "\t")
+		fmt.Printf("\n ===> Bridge interface: Name [%s] <=== \n%s\n", bi.Name, string(jsonbridge))
+		return "Bridge interface Create -- done", err
+
+	case "vlan":
+		if len(args) != 3 {
+			return "Format:\n    CREATE vlan <Parent Port ID> <VLAN number>", nil
+		}
+		// CREATE vlan <Parent Port ID> <VLAN number>
+		value, err := strconv.Atoi(args[2])
+		if err != nil {
+			return "'" + args[2] + "'" + " is not a valid VLAN number", nil
+		}
+		vlan := new(nuage_v3_2.VLAN)
+		vlan.ParentID = args[1]
+		vlan.Value = value
+		err = vlan.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonvlan, _ := json.MarshalIndent(vlan, "", "\t")
+		fmt.Printf("\n ===> VLAN: VLAN [%d] <=== \n%s\n", vlan.Value, string(jsonvlan))
+		return "VLAN Create -- done", err
+
+	case "gatewayvport":
+		if len(args) != 6 {
+			return "Format:\n    CREATE gatewayvport <Name> <BRIDGE | HOST> <subnet | l2domain> <Parent ID> <VLAN ID>", nil
+		}
+		// CREATE gatewayvport <Name> <BRIDGE | HOST> <subnet | l2domain> <Parent ID> <VLAN ID>
+		vlan := new(nuage_v3_2.VLAN)
+		vlan.ID = args[5]
+
+		var vport nuage_v3_2.VPort
+		vport.Name = args[1]
+		vport.Type = args[2]
+		vport.ParentType = args[3]
+		vport.ParentID = args[4]
+		vport.Active = true
+
+		vp, err := vlan.CreateVPort(myconn, vport)
+		if err != nil {
+			return "", err
+		}
+		jsonvp, _ := json.MarshalIndent(vp, "", "\t")
+		fmt.Printf("\n ===> Created VPort: Name [%s] Type [%s] <=== \n%s\n", vp.Name, vp.Type, string(jsonvp))
+		return "Gateway VPort Create -- done", err
+
+	case "dhcpoption":
+		if len(args) < 5 {
+			return "Format:\n    CREATE dhcpoption <domain | zone | subnet | vport | l2domain> <Parent ID> <Option name | code> <Value> [ <Value> ... ]", nil
+		}
+		// CREATE dhcpoption <Parent type> <Parent ID> <Option name | code> <Value> [ <Value> ... ]
+		code, found := nuage_v3_2.DHCPOptionCodes[args[3]]
+		if !found {
+			var err error
+			code, err = strconv.Atoi(args[3])
+			if err != nil {
+				return "'" + args[3] + "'" + " is not a known DHCP option. Use a DHCP option code or one of: " + dhcpoptionnames(), nil
+			}
+		}
+		o := new(nuage_v3_2.DHCPOption)
+		o.ParentType = args[1]
+		o.ParentID = args[2]
+		err := o.Encode(code, args[4:]...)
+		if err != nil {
+			return "", err
+		}
+		err = o.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsono, _ := json.MarshalIndent(o, "", "\t")
+		fmt.Printf("\n ===> DHCP option: Type [%s] <=== \n%s\n", o.Type, string(jsono))
+		return "DHCP option Create -- done", err
+
+	case "multicastchannelmap":
+		if len(args) != 2 {
+			return "Format:\n    CREATE multicastchannelmap <Name>", nil
+		}
+		// CREATE multicastchannelmap <Name>
+		cm := new(nuage_v3_2.MulticastChannelMap)
+		cm.Name = args[1]
+		err := cm.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsoncm, _ := json.MarshalIndent(cm, "", "\t")
+		fmt.Printf("\n ===> Multicast channel map: Name [%s] <=== \n%s\n", cm.Name, string(jsoncm))
+		return "Multicast channel map Create -- done", err
+
+	case "multicastrange":
+		if len(args) != 4 {
+			return "Format:\n    CREATE multicastrange <Channel map ID> <Lowest address> <Highest address>", nil
+		}
+		// CREATE multicastrange <Channel map ID> <Lowest address> <Highest address>
+		mr := new(nuage_v3_2.MulticastRange)
+		mr.ParentID = args[1]
+		mr.MinAddress = args[2]
+		mr.MaxAddress = args[3]
+		err := mr.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonmr, _ := json.MarshalIndent(mr, "", "\t")
+		fmt.Printf("\n ===> Multicast range: [%s - %s] <=== \n%s\n", mr.MinAddress, mr.MaxAddress, string(jsonmr))
+		return "Multicast range Create -- done", err
+
+	case "multicastlist":
+		if len(args) < 2 || len(args) > 3 || (len(args) == 3 && args[2] != "unlimited") {
+			return "Format:\n    CREATE multicastlist <Parent Enterprise ID> [ unlimited ]", nil
+		}
+		// CREATE multicastlist <Parent Enterprise ID> [ unlimited ]
+		ml := new(nuage_v3_2.MulticastList)
+		ml.ParentID = args[1]
+		ml.Unlimited = len(args) == 3
+		err := ml.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonml, _ := json.MarshalIndent(ml, "", "\t")
+		fmt.Printf("\n ===> Multicast list: ID [%s] <=== \n%s\n", ml.ID, string(jsonml))
+		return "Multicast list Create -- done", err
+
+	case "qos":
+		format := "Format:\n    CREATE qos <Name> <domain | zone | subnet | vport | l2domain> <Parent ID> <Peak rate, e.g. 100Mbps> <Burst size, e.g. 512KB> [ <Committed rate> ]\n    CREATE qos <Name> <domain | zone | subnet | vport | l2domain> <Parent ID> ratelimiter=<Rate limiter ID>"
+		if len(args) < 5 || len(args) > 7 {
+			return format, nil
+		}
+		q := new(nuage_v3_2.QoS)
+		q.Name = args[1]
+		q.ParentType = args[2]
+		q.ParentID = args[3]
+		if strings.HasPrefix(args[4], "ratelimiter=") {
+			// CREATE qos <Name> <Parent type> <Parent ID> ratelimiter=<Rate limiter ID>
+			if len(args) != 5 {
+				return format, nil
+			}
+			rl := new(nuage_v3_2.RateLimiter)
+			rl.ID = strings.TrimPrefix(args[4], "ratelimiter=")
+			err := rl.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			q.ApplyRateLimiter(*rl)
+		} else {
+			// CREATE qos <Name> <Parent type> <Parent ID> <Peak rate> <Burst size> [ <Committed rate> ]
+			if len(args) < 6 {
+				return format, nil
+			}
+			var err error
+			if q.Peak, err = nuage_v3_2.ParseRate(args[4]); err != nil {
+				return "", err
+			}
+			if q.Burst, err = nuage_v3_2.ParseBurst(args[5]); err != nil {
+				return "", err
+			}
+			if len(args) == 7 {
+				if q.CommittedInformationRate, err = nuage_v3_2.ParseRate(args[6]); err != nil {
+					return "", err
+				}
+			}
+		}
+		err := q.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonq, _ := json.MarshalIndent(q, "", "\t")
+		fmt.Printf("\n ===> QoS: Name [%s] <=== \n%s\n", q.Name, string(jsonq))
+		return "QoS Create -- done", err
+
+	case "ratelimiter":
+		if len(args) < 5 || len(args) > 6 {
+			return "Format:\n    CREATE ratelimiter <Name> <Parent Enterprise ID> <Peak rate, e.g. 100Mbps> <Burst size, e.g. 512KB> [ <Committed rate> ]", nil
+		}
+		// CREATE ratelimiter <Name> <Parent Enterprise ID> <Peak rate> <Burst size> [ <Committed rate> ]
+		rl := new(nuage_v3_2.RateLimiter)
+		rl.Name = args[1]
+		rl.ParentID = args[2]
+		var err error
+		if rl.PeakInformationRate, err = nuage_v3_2.ParseRate(args[3]); err != nil {
+			return "", err
+		}
+		if rl.PeakBurstSize, err = nuage_v3_2.ParseBurst(args[4]); err != nil {
+			return "", err
+		}
+		if len(args) == 6 {
+			if rl.CommittedInformationRate, err = nuage_v3_2.ParseRate(args[5]); err != nil {
+				return "", err
+			}
+		}
+		err = rl.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonrl, _ := json.MarshalIndent(rl, "", "\t")
+		fmt.Printf("\n ===> Rate limiter: Name [%s] <=== \n%s\n", rl.Name, string(jsonrl))
+		return "Rate limiter Create -- done", err
+
+	case "user":
+		if len(args) != 6 {
+			return "Format:\n    CREATE user <User name> <Parent Enterprise ID> <First name> <Last name> <Email>", nil
+		}
+		// CREATE user <User name> <Parent Enterprise ID> <First name> <Last name> <Email>
+		// The password is prompted for, never taken from the command line
+		password, err := readpassword()
+		if err != nil {
+			return "", err
+		}
+		u := new(nuage_v3_2.User)
+		u.UserName = args[1]
+		u.ParentID = args[2]
+		u.FirstName = args[3]
+		u.LastName = args[4]
+		u.Email = args[5]
+		u.SetPassword(password)
+		err = u.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		// Never display passwords, not even hashed
+		u.Password = ""
+		jsonuser, _ := json.MarshalIndent(u, "", "\t")
+		fmt.Printf("\n ===> User: User name [%s] <=== \n%s\n", u.UserName, string(jsonuser))
+		return "User Create -- done", err
+
+	case "group":
+		if len(args) != 3 && len(args) != 4 {
+			return "Format:\n    CREATE group <Name> <Parent Enterprise ID> [ <Role> ]", nil
+		}
+		// CREATE group <Name> <Parent Enterprise ID> [ <Role> ]
+		g := new(nuage_v3_2.Group)
+		g.Name = args[1]
+		g.ParentID = args[2]
+		if len(args) == 4 {
+			g.Role = args[3]
+		}
+		err := g.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsongroup, _ := json.MarshalIndent(g, "", "\t")
+		fmt.Printf("\n ===> Group: Name [%s] <=== \n%s\n", g.Name, string(jsongroup))
+		return "Group Create -- done", err
+
+	case "networkmacro":
+		if len(args) != 4 {
+			return "Format:\n    CREATE networkmacro <Name> <Parent Enterprise ID> <Address/Prefix length>", nil
+		}
+		// CREATE networkmacro <Name> <Parent Enterprise ID> <CIDR>
+		ip, ipnet, err := net.ParseCIDR(args[3])
+		if err != nil {
+			return "'" + args[3] + "'" + " is not a valid CIDR network, e.g. 192.0.2.0/24", nil
+		}
+		if !ip.Equal(ipnet.IP) {
+			return "'" + args[3] + "'" + " has host bits set, did you mean " + ipnet.String() + " ?", nil
+		}
+		en := new(nuage_v3_2.EnterpriseNetwork)
+		en.Name = args[1]
+		en.ParentID = args[2]
+		en.Address = ipnet.IP.String()
+		en.Netmask = net.IP(ipnet.Mask).String()
+		err = en.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonen, _ := json.MarshalIndent(en, "", "\t")
+		fmt.Printf("\n ===> Network macro: Name [%s] Network [%s] <=== \n%s\n", en.Name, tocidr(en.Address, en.Netmask), string(jsonen))
+		return "Network macro Create -- done", err
+
+	case "networkmacrogroup":
+		if len(args) != 3 {
+			return "Format:\n    CREATE networkmacrogroup <Name> <Parent Enterprise ID>", nil
+		}
+		// CREATE networkmacrogroup <Name> <Parent Enterprise ID>
+		nmg := new(nuage_v3_2.NetworkMacroGroup)
+		nmg.Name = args[1]
+		nmg.ParentID = args[2]
+		err := nmg.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonnmg, _ := json.MarshalIndent(nmg, "", "\t")
+		fmt.Printf("\n ===> Network macro group: Name [%s] <=== \n%s\n", nmg.Name, string(jsonnmg))
+		return "Network macro group Create -- done", err
+
+	case "staticroute":
+		if len(args) != 6 && len(args) != 7 {
+			return "Format:\n    CREATE staticroute <domain | l2domain> <Parent ID> <Address> <Netmask> <Next hop> [ OVERLAY | EXIT_DOMAIN ]", nil
+		}
+		// CREATE staticroute <domain | l2domain> <Parent ID> <Address> <Netmask> <Next hop> [ OVERLAY | EXIT_DOMAIN ]
+		sr := new(nuage_v3_2.StaticRoute)
+		sr.ParentType = args[1]
+		sr.ParentID = args[2]
+		sr.Address = args[3]
+		sr.Netmask = args[4]
+		sr.NextHopIp = args[5]
+		if len(args) == 7 {
+			sr.Type = args[6]
+		}
+		err := sr.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonsr, _ := json.MarshalIndent(sr, "", "\t")
+		fmt.Printf("\n ===> Static route: [%s/%s] via [%s] <=== \n%s\n", sr.Address, sr.Netmask, sr.NextHopIp, string(jsonsr))
+		return "Static route Create -- done", err
+
+	case "fippool":
+		if len(args) != 5 {
+			return "Format:\n    CREATE fippool <Name> <Address> <Netmask> <Gateway>", nil
+		}
+		// CREATE fippool <Name> <Address> <Netmask> <Gateway>
+		for _, ip := range args[2:] {
+			if net.ParseIP(ip) == nil {
+				return "'" + ip + "'" + " is not a valid IP address", nil
+			}
+		}
+		pool := new(nuage_v3_2.SharedNetworkResource)
+		pool.Name = args[1]
+		pool.Type = "FLOATING"
+		pool.Address = args[2]
+		pool.Netmask = args[3]
+		pool.Gateway = args[4]
+		err := pool.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonpool, _ := json.MarshalIndent(pool, "", "\t")
+		fmt.Printf("\n ===> FIP pool: Name [%s] <=== \n%s\n", pool.Name, string(jsonpool))
+		return "FIP pool Create -- done", err
+
+	case "floatingip":
+		if len(args) != 3 && len(args) != 4 {
+			return "Format:\n    CREATE floatingip <Parent Domain ID> <FIP pool ID> [ <Address> ]", nil
+		}
+		// CREATE floatingip <Parent Domain ID> <FIP pool ID> [ <Address> ]
+		fip := new(nuage_v3_2.FloatingIP)
+		fip.ParentID = args[1]
+		fip.AssociatedSharedNetworkResourceID = args[2]
+		if len(args) == 4 {
+			if net.ParseIP(args[3]) == nil {
+				return "'" + args[3] + "'" + " is not a valid IP address", nil
+			}
+			fip.Address = args[3]
+		}
+		err := fip.Allocate(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonfip, _ := json.MarshalIndent(fip, "", "\t")
+		fmt.Printf("\n ===> Floating IP: Address [%s] <=== \n%s\n", fip.Address, string(jsonfip))
+		err = printfipquota(fip.ParentID)
+		return "Floating IP Allocate -- done", err
+
+	case "policygroup":
+		if len(args) < 3 {
+			return "Format:\n    CREATE policygroup <Name> <Parent Domain ID> [ <Policy group template ID> ]", nil
+		}
+		// CREATE policygroup <Name> <Parent Domain ID> [ <Policy group template ID> ]
+		pg := new(nuage_v3_2.PolicyGroup)
+		pg.Name = args[1]
+		pg.ParentID = args[2]
+		if len(args) >= 4 {
+			pg.TemplateID = args[3]
+		}
+		err := pg.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonpg, _ := json.MarshalIndent(pg, "", "\t")
+		fmt.Printf("\n ===> Policy group: Name [%s] <=== \n%s\n", pg.Name, string(jsonpg))
+		return "Policy group Create -- done", err
+
+	case "policygrouptemplate":
+		if len(args) != 3 {
+			return "Format:\n    CREATE policygrouptemplate <Name> <Parent Domain template ID>", nil
+		}
+		// CREATE policygrouptemplate <Name> <Parent Domain template ID>
+		pgt := new(nuage_v3_2.PolicyGrouptemplate)
+		pgt.Name = args[1]
+		pgt.ParentID = args[2]
+		err := pgt.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonpgt, _ := json.MarshalIndent(pgt, "", "\t")
+		fmt.Printf("\n ===> Policy group template: Name [%s] <=== \n%s\n", pgt.Name, string(jsonpgt))
+		return "Policy group template Create -- done", err
+
+	case "ingressacltemplate":
+		if len(args) != 4 {
+			return "Format:\n    CREATE ingressacltemplate <Name> <domain | domaintemplate | l2domain | l2domaintemplate> <Parent ID>", nil
+		}
+		// CREATE ingressacltemplate <Name> <Parent type> <Parent ID>
+		acl := new(nuage_v3_2.IngressACLTemplate)
+		acl.Name = args[1]
+		acl.ParentType = args[2]
+		acl.ParentID = args[3]
+		acl.Active = true
+		err := acl.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonacl, _ := json.MarshalIndent(acl, "", "\t")
+		fmt.Printf("\n ===> Ingress ACL template: Name [%s] <=== \n%s\n", acl.Name, string(jsonacl))
+		return "Ingress ACL template Create -- done", err
+
+	case "ingressaclentry":
+		if len(args) < 4 {
+			return "Format:\n    CREATE ingressaclentry <Ingress ACL template ID> <Priority> <FORWARD | DROP> [ protocol=<tcp | udp | icmp | any | number> srcport=<port | range | *> dstport=<port | range | *> networktype=<type> networkid=<ID> locationtype=<type> locationid=<ID> stateful=<true | false> ethertype=<ethertype> dscp=<dscp> description=<text> ]", nil
+		}
+		// CREATE ingressaclentry <Ingress ACL template ID> <Priority> <FORWARD | DROP> [ key=value ... ]
+		e, err := aclentryfromargs(args)
+		if err != nil {
+			return err.Error(), nil
+		}
+		entry := nuage_v3_2.IngressACLEntry(e)
+		err = (&entry).Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonentry, _ := json.MarshalIndent(entry, "", "\t")
+		fmt.Printf("\n ===> Ingress ACL entry: Priority [%d] <=== \n%s\n", entry.Priority, string(jsonentry))
+		return "Ingress ACL entry Create -- done", err
+
+	case "egressacltemplate":
+		if len(args) != 4 {
+			return "Format:\n    CREATE egressacltemplate <Name> <domain | domaintemplate | l2domain | l2domaintemplate> <Parent ID>", nil
+		}
+		// CREATE egressacltemplate <Name> <Parent type> <Parent ID>
+		acl := new(nuage_v3_2.EgressACLTemplate)
+		acl.Name = args[1]
+		acl.ParentType = args[2]
+		acl.ParentID = args[3]
+		acl.Active = true
+		err := acl.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonacl, _ := json.MarshalIndent(acl, "", "\t")
+		fmt.Printf("\n ===> Egress ACL template: Name [%s] <=== \n%s\n", acl.Name, string(jsonacl))
+		return "Egress ACL template Create -- done", err
+
+	case "egressaclentry":
+		if len(args) < 4 {
+			return "Format:\n    CREATE egressaclentry <Egress ACL template ID> <Priority> <FORWARD | DROP> [ protocol=<tcp | udp | icmp | any | number> srcport=<port | range | *> dstport=<port | range | *> networktype=<type> networkid=<ID> locationtype=<type> locationid=<ID> stateful=<true | false> ethertype=<ethertype> dscp=<dscp> description=<text> ]", nil
+		}
+		// CREATE egressaclentry <Egress ACL template ID> <Priority> <FORWARD | DROP> [ key=value ... ]
+		e, err := aclentryfromargs(args)
+		if err != nil {
+			return err.Error(), nil
+		}
+		entry := nuage_v3_2.EgressACLEntry(e)
+		err = (&entry).Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonentry, _ := json.MarshalIndent(entry, "", "\t")
+		fmt.Printf("\n ===> Egress ACL entry: Priority [%d] <=== \n%s\n", entry.Priority, string(jsonentry))
+		return "Egress ACL entry Create -- done", err
+
+	case "ingressadvfwdtemplate":
+		if len(args) != 4 {
+			return "Format:\n    CREATE ingressadvfwdtemplate <Name> <domain | domaintemplate | l2domain | l2domaintemplate> <Parent ID>", nil
+		}
+		// CREATE ingressadvfwdtemplate <Name> <Parent type> <Parent ID>
+		afwd := new(nuage_v3_2.IngressAdvFwdTemplate)
+		afwd.Name = args[1]
+		afwd.ParentType = args[2]
+		afwd.ParentID = args[3]
+		afwd.Active = true
+		err := afwd.Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonafwd, _ := json.MarshalIndent(afwd, "", "\t")
+		fmt.Printf("\n ===> Ingress advanced forwarding template: Name [%s] <=== \n%s\n", afwd.Name, string(jsonafwd))
+		return "Ingress advanced forwarding template Create -- done", err
+
+	case "ingressadvfwdentry":
+		if len(args) < 4 {
+			return "Format:\n    CREATE ingressadvfwdentry <Ingress advanced forwarding template ID> <Priority> <FORWARD | DROP | REDIRECT> [ redirecttarget=<Redirection target ID> protocol=<tcp | udp | icmp | any | number> srcport=<port | range | *> dstport=<port | range | *> networktype=<type> networkid=<ID> locationtype=<type> locationid=<ID> ethertype=<ethertype> dscp=<dscp> description=<text> ]", nil
+		}
+		// CREATE ingressadvfwdentry <Ingress advanced forwarding template ID> <Priority> <FORWARD | DROP | REDIRECT> [ key=value ... ]
+		entry, err := advfwdentryfromargs(args)
+		if err != nil {
+			return err.Error(), nil
+		}
+		err = (&entry).Create(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonentry, _ := json.MarshalIndent(entry, "", "\t")
+		fmt.Printf("\n ===> Ingress advanced forwarding entry: Priority [%d] <=== \n%s\n", entry.Priority, string(jsonentry))
+		return "Ingress advanced forwarding entry Create -- done", err
+
+	case "vport":
+		if len(args) < 3 {
+			return "Format:\n    CREATE vport <Name> <Parent Subnet ID> [ type=<VM | HOST | BRIDGE> vlan=<VLAN ID> addressspoofing=<INHERITED | ENABLED | DISABLED> description=<text> ]", nil
+		}
+		// CREATE vport <Name> <Parent Subnet ID> [ options ...]
+		opts, err := parseopts(args[3:])
+		if err != nil {
+			return "", err
+		}
+
+		subnet := new(nuage_v3_2.Subnet)
+		subnet.ID = args[2]
+
+		var vport nuage_v3_2.VPort
+		vport.Name = args[1]
+		vport.Type = "VM"
+		vport.AddressSpoofing = "INHERITED"
+
+		for k, v := range opts {
+			switch k {
+			case "type":
+				vport.Type = strings.ToUpper(v)
+			case "vlan":
+				vport.VLANID = v
+			case "addressspoofing":
+				vport.AddressSpoofing = strings.ToUpper(v)
+			case "description":
+				vport.Description = v
+			default:
+				return "Unknown VPort option: [" + k + "]", nil
+			}
+		}
+
+		vport.Active = true
+		// ???? Not needed but still....
+		vport.ParentID = subnet.ID
+		vport.ParentType = "subnet"
+
+		vp, shown, err := apiaddvport(subnet, vport)
+
+		if err != nil {
+			return "", err
+		}
+
+		jsonvp, err := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Created VPort: Name [%s] <=== \n%#s\n", vp.Name, string(jsonvp))
+
+		return "VPort Create -- done", err
+
+	case "vm":
+		if len(args) != 5 {
+			return "Format:\n    CREATE vm <Name> <UUID> <Interface0-MAC> <Interface0-VPortID>", nil
+		}
+		// CREATE vm <Name> <UUID> <Interface0-MAC> <Interface0-VPortID>
+		var vm nuage_v3_2.VirtualMachine
+		vm.Name = args[1]
+		vm.UUID = args[2]
+
+		var vmi nuage_v3_2.VMInterface
+		vmi.MAC = args[3]
+		vmi.VPortID = args[4]
+
+		vm.Interfaces = append(vm.Interfaces, vmi)
+
+		shown, err := apicreate(&vm)
+		if err != nil {
+			return "", err
+		}
+		jsonvm, _ := json.MarshalIndent(shown, "", "\t")
+		fmt.Printf("\n ===> Virtual Machine: Name [%s] <=== \n%#s\n", vm.Name, string(jsonvm))
+		return "Virtual Machine Create -- done", err
+
+	default:
+		return "Don't know how to create Nuage API entity: [" + entity + "]" + " with Name [" + args[1] + "]" + " and options: " + strings.Join(args[2:], " "), nil
+	}
+	return "", nil
+}
+
+func Get(args ...string) (string, error) {
+	// 1 argument:  <entity>
+	// 2 arguments: <entity> <ID>
+	// 3 arguments: <entity> <ID> <children>
+
+	// Lookup by tag: <entity> --tag <key>[=<value>]
+
+	if len(args) < 1 || len(args) > 3 {
+		return "GET <entity> [ <ID> [ <children> ] ]  |  GET <entity> --tag <key>[=<value>]", nil
+	}
+
+	entity := args[0]
+
+	if len(args) == 3 && args[1] == "--tag" {
+		return gettagged(entity, args[2])
+	}
+
+	switch entity {
+	case "enterprises":
+		switch len(args) {
+		case 1: // GET enterprises
+			var orglist nuage_v3_2.EnterpriseSlice
+			shown, err := apilist(&orglist, "")
+
+			if err != nil {
+				return "", err
+			}
+
+			// Yuck -- We need to to this hacky type cast since we can iterate through "[]nuage_v3_2.Enterprise"  but not "nuage_v3_2.EnterpriseSlice"
+			var orgs []nuage_v3_2.Enterprise
+			orgs = orglist
+
+			// Iterate through the list of org's and JSON pretty-print them
+			for i := range orgs {
+				org, _ := json.MarshalIndent(shown[i], "", "\t")
+				fmt.Printf("\n\n ===> Org nr [%d]: Name [%s] <=== \n%#s\n", i, orgs[i].Name, string(org))
+				printmulticastlist("Send", orgs[i].SendMultiCastListID)
+				printmulticastlist("Receive", orgs[i].ReceiveMultiCastListID)
+			}
+
+			return "Enterprise list -- done", err
+
+		case 2: // GET enterprises <ID>
+
+			org := new(nuage_v3_2.Enterprise)
+			org.ID = args[1]
+			shown, err := apiget(org)
+			if err != nil {
+				return "", err
+			}
+
+			// JSON pretty-print the org
+			jsonorg, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n\n ===> Org: Name [%s] <=== \n%#s\n", org.Name, string(jsonorg))
+			printmulticastlist("Send", org.SendMultiCastListID)
+			printmulticastlist("Receive", org.ReceiveMultiCastListID)
+
+			return "Enterprise Get ID -- done", err
+
+		case 3:
+			entityid := args[1]
+			child := args[2]
+
+			switch child {
+			case "domaintemplates": // GET enterprises <ID> domaintemplates
+
+				// Get list of domain templates for that org
+				var dts nuage_v3_2.Domaintemplateslice
+				shown, err := apilist(&dts, entityid)
+				if err != nil {
+					return "", err
+				}
+				// Yucky -- type cast from nuage_v3_2.Domaintemplateslice to []nuage_v3_2.Domaintemplate
+				var dtl []nuage_v3_2.Domaintemplate
+				dtl = dts
+				// Iterate through the list of domain templates and JSON pretty-print them
+				fmt.Printf("\n ######## Domain templates for Enterprise ID: [%s] ########\n", entityid)
+				for i := range dtl {
+					dt, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> Domain template nr [%d]: Name [%s] <=== \n%#s\n", i, dtl[i].Name, string(dt))
+				}
+
+				return "Domain template list -- done", err
+
+			case "domains": // GET enterprises <ID> domains
+				// Get list of domains for the Enterprise ID
+
+				var ds nuage_v3_2.Domainslice
+				shown, err := apilist(&ds, entityid)
+				if err != nil {
+					return "", err
+				}
+				// Yucky -- type cast from nuage_v3_2.Domainslice to []nuage_v3_2.Domain
+				var dl []nuage_v3_2.Domain
+				dl = ds
+				// Iterate through the list of domains and JSON pretty-print them
+				fmt.Printf("\n ######## Domains for Enterprise ID: [%s] ########\n", entityid)
+				for i := range dl {
+					domain, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> Domain nr [%d]: Name [%s] <=== \n%#s\n", i, dl[i].Name, string(domain))
+					printchannelmap("", dl[i].AssociatedMulticastChannelMapID)
+				}
+
+				return "Domain list -- done", err
+
+			case "l2domaintemplates": // GET enterprises <ID> l2domaintemplates
+				var l2dts nuage_v3_2.L2Domaintemplateslice
+				err := l2dts.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## L2 Domain templates for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range l2dts {
+					l2dt, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> L2 Domain template nr [%d]: Name [%s] <=== \n%s\n", i, l2dts[i].Name, string(l2dt))
+				}
+
+				return "L2 Domain template list -- done", err
+
+			case "l2domains": // GET enterprises <ID> l2domains
+				var l2ds nuage_v3_2.L2Domainslice
+				err := l2ds.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## L2 Domains for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range l2ds {
+					l2domain, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> L2 Domain nr [%d]: Name [%s] <=== \n%s\n", i, l2ds[i].Name, string(l2domain))
+					printchannelmap("", l2ds[i].AssociatedMulticastChannelMapID)
+				}
+
+				return "L2 Domain list -- done", err
+
+			case "networkmacros": // GET enterprises <ID> networkmacros
+				var ens nuage_v3_2.EnterpriseNetworkslice
+				err := ens.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Network macros for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range ens {
+					jsonen, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Network macro nr [%d]: Name [%s] Network [%s] <=== \n%s\n", i, ens[i].Name, tocidr(ens[i].Address, ens[i].Netmask), string(jsonen))
+				}
+				return "Network macro list -- done", err
+
+			case "networkmacrogroups": // GET enterprises <ID> networkmacrogroups
+				var nmgs nuage_v3_2.NetworkMacroGroupslice
+				err := nmgs.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Network macro groups for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range nmgs {
+					jsonnmg, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Network macro group nr [%d]: Name [%s] <=== \n%s\n", i, nmgs[i].Name, string(jsonnmg))
+				}
+				return "Network macro group list -- done", err
+
+			case "users": // GET enterprises <ID> users
+				var users nuage_v3_2.Userslice
+				err := users.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Users for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range users {
+					// Never display passwords, not even hashed
+					v.Password = ""
+					jsonuser, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> User nr [%d]: User name [%s] <=== \n%s\n", i, users[i].UserName, string(jsonuser))
+				}
+				return "User list -- done", err
+
+			case "groups": // GET enterprises <ID> groups
+				var groups nuage_v3_2.Groupslice
+				err := groups.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Groups for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range groups {
+					jsongroup, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Group nr [%d]: Name [%s] <=== \n%s\n", i, groups[i].Name, string(jsongroup))
+				}
+				return "Group list -- done", err
+
+			case "gateways": // GET enterprises <ID> gateways
+				var gws nuage_v3_2.Gatewayslice
+				err := gws.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Gateways for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range gws {
+					jsongw, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Gateway nr [%d]: Name [%s] <=== \n%s\n", i, gws[i].Name, string(jsongw))
+				}
+				return "Gateway list -- done", err
+
+			case "ratelimiters": // GET enterprises <ID> ratelimiters
+				var rls nuage_v3_2.RateLimiterslice
+				err := rls.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Rate limiters for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range rls {
+					jsonrl, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Rate limiter nr [%d]: Name [%s] <=== \n%s\n", i, rls[i].Name, string(jsonrl))
+				}
+				return "Rate limiter list -- done", err
+
+			case "multicastlists": // GET enterprises <ID> multicastlists
+				var mls nuage_v3_2.MulticastListslice
+				err := mls.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Multicast lists for Enterprise ID: [%s] ########\n", entityid)
+				for _, v := range mls {
+					printmulticastlist("", v.ID)
+				}
+				return "Multicast list list -- done", err
+
+			case "applicationservices": // GET enterprises <ID> applicationservices
+				var ass nuage_v3_2.ApplicationServiceslice
+				err := ass.List(myconn, "enterprise", entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Application services for Enterprise ID: [%s] ########\n", entityid)
+				printappservices(ass)
+				return "Application service list -- done", err
+
+			case "multinicvports": // GET enterprises <ID> multinicvports
+				var mnvs nuage_v3_2.MultiNICVPortslice
+				err := mnvs.List(myconn, entityid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Multi-NIC VPorts for Enterprise ID: [%s] ########\n", entityid)
+				for i, v := range mnvs {
+					jsonmnv, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Multi-NIC VPort nr [%d]: Name [%s] <=== \n%s\n", i, mnvs[i].Name, string(jsonmnv))
+				}
+				return "Multi-NIC VPort list -- done", err
+			}
+		}
+	case "l2domaintemplates":
+		switch len(args) {
+		case 1: // GET l2domaintemplates
+			// Global list of all L2 domain templates
+			var l2dts nuage_v3_2.L2Domaintemplateslice
+			err := l2dts.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range l2dts {
+				jsonl2dt, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> L2 Domain template nr [%d]: Name [%s] <=== \n%s\n", i, l2dts[i].Name, string(jsonl2dt))
+			}
+			return "L2 Domain template list -- done", err
+		case 2: // GET l2domaintemplates <ID>
+			l2dt := new(nuage_v3_2.L2Domaintemplate)
+			l2dt.ID = args[1]
+			err := l2dt.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonl2dt, _ := json.MarshalIndent(l2dt, "", "\t")
+			fmt.Printf("\n ===> L2 Domain Template: Name [%s] <=== \n%s\n", l2dt.Name, string(jsonl2dt))
+			return "L2 Domain Template Get -- done", err
+		}
+	case "l2domains":
+		switch len(args) {
+		case 1: // GET l2domains
+			// Global list of all L2 domains
+			var l2ds nuage_v3_2.L2Domainslice
+			err := l2ds.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range l2ds {
+				jsonl2domain, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> L2 Domain nr [%d]: Name [%s] <=== \n%s\n", i, l2ds[i].Name, string(jsonl2domain))
+				printchannelmap("", l2ds[i].AssociatedMulticastChannelMapID)
+			}
+			return "L2 Domain list -- done", err
+		case 2: // GET l2domains <ID>
+			l2domain := new(nuage_v3_2.L2Domain)
+			l2domain.ID = args[1]
+			err := l2domain.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonl2domain, _ := json.MarshalIndent(l2domain, "", "\t")
+			fmt.Printf("\n ===> L2 Domain Name [%s] <=== \n%s\n", l2domain.Name, string(jsonl2domain))
+			printchannelmap("", l2domain.AssociatedMulticastChannelMapID)
+			return "L2 Domain Get -- done", err
+		case 3:
+			l2domain := new(nuage_v3_2.L2Domain)
+			l2domain.ID = args[1]
+			switch args[2] {
+			case "vports": // GET l2domains <ID> vports
+				vports, err := l2domain.VPortsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range vports {
+					jsonvport, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%s\n", i, vports[i].Name, string(jsonvport))
+					printchannelmap("Receive", vports[i].AssociatedMulticastChannelMapID)
+					printchannelmap("Send", vports[i].AssociatedSendMulticastChannelMapID)
+				}
+				return "L2 Domain VPorts list -- done", err
+
+			case "vminterfaces": // GET l2domains <ID> vminterfaces
+				vmis, err := l2domain.VMInterfacesList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range vmis {
+					jsonvmi, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%s\n", i, vmis[i].Name, string(jsonvmi))
+				}
+				return "L2 Domain VMInterfaces list -- done", err
+
+			case "bridgeinterfaces": // GET l2domains <ID> bridgeinterfaces
+				bis, err := l2domain.BridgeInterfacesList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range bis {
+					jsonbridge, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Bridge interface nr [%d]: Name [%s] <=== \n%s\n", i, bis[i].Name, string(jsonbridge))
+				}
+				return "L2 Domain Bridge interfaces list -- done", err
+
+			case "ingressadvfwdtemplates": // GET l2domains <ID> ingressadvfwdtemplates
+				var afwds nuage_v3_2.IngressAdvFwdTemplateslice
+				err := afwds.List(myconn, "l2domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Ingress advanced forwarding templates for L2 Domain ID: [%s] ########\n", args[1])
+				for i, v := range afwds {
+					jsonafwd, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Ingress advanced forwarding template nr [%d]: Name [%s] <=== \n%s\n", i, afwds[i].Name, string(jsonafwd))
+				}
+				return "Ingress advanced forwarding template list -- done", err
+
+			case "redirectiontargets": // GET l2domains <ID> redirectiontargets
+				var rts nuage_v3_2.RedirectionTargetslice
+				err := rts.List(myconn, "l2domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Redirection targets for L2 Domain ID: [%s] ########\n", args[1])
+				for i, v := range rts {
+					jsonrt, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Redirection target nr [%d]: Name [%s] <=== \n%s\n", i, rts[i].Name, string(jsonrt))
+				}
+				return "Redirection target list -- done", err
+
+			case "staticroutes": // GET l2domains <ID> staticroutes
+				var srs nuage_v3_2.StaticRouteslice
+				err := srs.List(myconn, "l2domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Static routes for L2 Domain ID: [%s] ########\n", args[1])
+				for i, v := range srs {
+					jsonsr, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Static route nr [%d]: [%s/%s] via [%s] <=== \n%s\n", i, srs[i].Address, srs[i].Netmask, srs[i].NextHopIp, string(jsonsr))
+				}
+				return "Static route list -- done", err
+			}
+		}
+	case "domaintemplates":
+		switch len(args) {
+		case 2: // GET domaintemplates <ID>
+			dt := new(nuage_v3_2.Domaintemplate)
+			dt.ID = args[1]
+			shown, err := apiget(dt)
+
+			if err != nil {
+				return "", err
+			}
+			// JSON pretty-print the domain template
+			jsondt, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Domain Template: Name [%s] <=== \n%#s\n", dt.Name, string(jsondt))
+			return "Domain Template Get -- done", err
+		case 3:
+			dtid := args[1]
 			child := args[2]
+			switch child {
+			case "zonetemplates": // GET domaintemplates <ID> zonetemplates
+				var zts nuage_v3_2.Zonetemplateslice
+				shown, err := apilist(&zts, dtid)
+				if err != nil {
+					return "", err
+				}
+				// Yucky -- type cast from nuage_v3_2.Zonetemplateslice to []nuage_v3_2.Zonetemplate
+				var zta []nuage_v3_2.Zonetemplate
+				zta = zts
+				// Iterate through the list of zone templates and JSON pretty-print them
+				fmt.Printf("\n ######## Zone templates for Domain template ID: [%s] ########\n", dtid)
+				for i := range zta {
+					zt, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> Zone template nr [%d]: Name [%s] <=== \n%#s\n", i, zta[i].Name, string(zt))
+				}
+
+				return "Zone template list -- done", err
+
+			case "ingressacltemplates": // GET domaintemplates <ID> ingressacltemplates
+				var acls nuage_v3_2.IngressACLTemplateslice
+				err := acls.List(myconn, "domaintemplate", dtid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Ingress ACL templates for Domain template ID: [%s] ########\n", dtid)
+				for i, v := range acls {
+					jsonacl, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Ingress ACL template nr [%d]: Name [%s] <=== \n%s\n", i, acls[i].Name, string(jsonacl))
+				}
+				return "Ingress ACL template list -- done", err
+
+			case "egressacltemplates": // GET domaintemplates <ID> egressacltemplates
+				var acls nuage_v3_2.EgressACLTemplateslice
+				err := acls.List(myconn, "domaintemplate", dtid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Egress ACL templates for Domain template ID: [%s] ########\n", dtid)
+				for i, v := range acls {
+					jsonacl, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Egress ACL template nr [%d]: Name [%s] <=== \n%s\n", i, acls[i].Name, string(jsonacl))
+				}
+				return "Egress ACL template list -- done", err
+
+			case "policygrouptemplates": // GET domaintemplates <ID> policygrouptemplates
+				var pgts nuage_v3_2.PolicyGrouptemplateslice
+				err := pgts.List(myconn, dtid)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Policy group templates for Domain template ID: [%s] ########\n", dtid)
+				for i, v := range pgts {
+					jsonpgt, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Policy group template nr [%d]: Name [%s] <=== \n%s\n", i, pgts[i].Name, string(jsonpgt))
+				}
+				return "Policy group template list -- done", err
+
+			case "aclrules": // GET domaintemplates <ID> aclrules
+				return printaclrules("domaintemplate", dtid)
+			}
+
+		}
+	case "domains":
+		switch len(args) {
+		case 1: // GET domains
+			// Get list of domains with "nil" as parent enterprise -- i.e. global list of all domains
+			var ds nuage_v3_2.Domainslice
+			shown, err := apilist(&ds, "")
+			if err != nil {
+				return "", err
+			}
+			// Yucky -- type cast from nuage_v3_2.Domainslice to []nuage_v3_2.Domain
+			var dl []nuage_v3_2.Domain
+			dl = ds
+			for i := range dl {
+				jsondomain, _ := json.MarshalIndent(shown[i], "", "\t")
+				fmt.Printf("\n ===> Domain nr [%d]: Name [%s] <=== \n%#s\n", i, dl[i].Name, string(jsondomain))
+				printchannelmap("", dl[i].AssociatedMulticastChannelMapID)
+			}
+			return "Domain list -- done", err
+		case 2: // GET domains <ID>
+			// Get a specific Domain ID
+			domain := new(nuage_v3_2.Domain)
+			domain.ID = args[1]
+			shown, err := apiget(domain)
+			if err != nil {
+				return "", err
+			}
+			jsondomain, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Domain Name [%s] <=== \n%#s\n", domain.Name, string(jsondomain))
+			printchannelmap("", domain.AssociatedMulticastChannelMapID)
+			return "Domain Get -- done", err
+		case 3:
+			switch args[2] {
+			case "vports": // GET domains <ID> vports
+				domain := new(nuage_v3_2.Domain)
+				domain.ID = args[1]
+				vports, shown, err := apivportslist(domain)
+				if err != nil {
+					return "", err
+				}
+				for i := range vports {
+					jsonvport, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%#s\n", i, vports[i].Name, string(jsonvport))
+					printchannelmap("Receive", vports[i].AssociatedMulticastChannelMapID)
+					printchannelmap("Send", vports[i].AssociatedSendMulticastChannelMapID)
+				}
+				return "Domain VPorts list -- done", err
+
+			case "vminterfaces": // GET domains <ID> vminterfaces
+				var domain nuage_v3_2.Domain
+				domain.ID = args[1]
+				vmis, shown, err := apivminterfaceslist(&domain)
+				if err != nil {
+					return "", err
+				}
+				for i := range vmis {
+					jsonvmi, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%#s\n", i, vmis[i].Name, string(jsonvmi))
+				}
+				return "Subnet VMInterfaces list -- done", err
+
+			case "hostinterfaces": // GET domains <ID> hostinterfaces
+				domain := new(nuage_v3_2.Domain)
+				domain.ID = args[1]
+				his, err := domain.HostInterfacesList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range his {
+					jsonhost, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Host interface nr [%d]: Name [%s] <=== \n%s\n", i, his[i].Name, string(jsonhost))
+				}
+				return "Domain Host interfaces list -- done", err
+
+			case "bridgeinterfaces": // GET domains <ID> bridgeinterfaces
+				domain := new(nuage_v3_2.Domain)
+				domain.ID = args[1]
+				bis, err := domain.BridgeInterfacesList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range bis {
+					jsonbridge, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Bridge interface nr [%d]: Name [%s] <=== \n%s\n", i, bis[i].Name, string(jsonbridge))
+				}
+				return "Domain Bridge interfaces list -- done", err
+
+			case "ingressacltemplates": // GET domains <ID> ingressacltemplates
+				var acls nuage_v3_2.IngressACLTemplateslice
+				err := acls.List(myconn, "domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Ingress ACL templates for Domain ID: [%s] ########\n", args[1])
+				for i, v := range acls {
+					jsonacl, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Ingress ACL template nr [%d]: Name [%s] <=== \n%s\n", i, acls[i].Name, string(jsonacl))
+				}
+				return "Ingress ACL template list -- done", err
+
+			case "egressacltemplates": // GET domains <ID> egressacltemplates
+				var acls nuage_v3_2.EgressACLTemplateslice
+				err := acls.List(myconn, "domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Egress ACL templates for Domain ID: [%s] ########\n", args[1])
+				for i, v := range acls {
+					jsonacl, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Egress ACL template nr [%d]: Name [%s] <=== \n%s\n", i, acls[i].Name, string(jsonacl))
+				}
+				return "Egress ACL template list -- done", err
+
+			case "floatingips": // GET domains <ID> floatingips
+				var fips nuage_v3_2.FloatingIPslice
+				err := fips.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Floating IPs for Domain ID: [%s] ########\n", args[1])
+				for i, v := range fips {
+					jsonfip, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Floating IP nr [%d]: Address [%s] <=== \n%s\n", i, fips[i].Address, string(jsonfip))
+				}
+				err = printfipquota(args[1])
+				return "Floating IP list -- done", err
+
+			case "staticroutes": // GET domains <ID> staticroutes
+				var srs nuage_v3_2.StaticRouteslice
+				err := srs.List(myconn, "domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Static routes for Domain ID: [%s] ########\n", args[1])
+				for i, v := range srs {
+					jsonsr, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Static route nr [%d]: [%s/%s] via [%s] <=== \n%s\n", i, srs[i].Address, srs[i].Netmask, srs[i].NextHopIp, string(jsonsr))
+				}
+				return "Static route list -- done", err
+
+			case "applications": // GET domains <ID> applications
+				var apps nuage_v3_2.Applicationslice
+				err := apps.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Applications for Domain ID: [%s] ########\n", args[1])
+				for i, v := range apps {
+					jsonapp, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Application nr [%d]: Name [%s] <=== \n%s\n", i, apps[i].Name, string(jsonapp))
+				}
+				return "Application list -- done", err
+
+			case "applicationservices": // GET domains <ID> applicationservices
+				var ass nuage_v3_2.ApplicationServiceslice
+				err := ass.List(myconn, "domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Application services for Domain ID: [%s] ########\n", args[1])
+				printappservices(ass)
+				return "Application service list -- done", err
+
+			case "ingressadvfwdtemplates": // GET domains <ID> ingressadvfwdtemplates
+				var afwds nuage_v3_2.IngressAdvFwdTemplateslice
+				err := afwds.List(myconn, "domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Ingress advanced forwarding templates for Domain ID: [%s] ########\n", args[1])
+				for i, v := range afwds {
+					jsonafwd, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Ingress advanced forwarding template nr [%d]: Name [%s] <=== \n%s\n", i, afwds[i].Name, string(jsonafwd))
+				}
+				return "Ingress advanced forwarding template list -- done", err
+
+			case "redirectiontargets": // GET domains <ID> redirectiontargets
+				var rts nuage_v3_2.RedirectionTargetslice
+				err := rts.List(myconn, "domain", args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Redirection targets for Domain ID: [%s] ########\n", args[1])
+				for i, v := range rts {
+					jsonrt, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Redirection target nr [%d]: Name [%s] <=== \n%s\n", i, rts[i].Name, string(jsonrt))
+				}
+				return "Redirection target list -- done", err
+
+			case "policygroups": // GET domains <ID> policygroups
+				var pgs nuage_v3_2.PolicyGroupslice
+				err := pgs.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Policy groups for Domain ID: [%s] ########\n", args[1])
+				for i, v := range pgs {
+					jsonpg, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Policy group nr [%d]: Name [%s] <=== \n%s\n", i, pgs[i].Name, string(jsonpg))
+				}
+				return "Policy group list -- done", err
+
+			case "aclrules": // GET domains <ID> aclrules
+				return printaclrules("domain", args[1])
+			}
+		}
+	case "zonetemplates":
+		if len(args) < 2 {
+			return "Format:\n    GET zonetemplates <ID>", nil
+		}
+
+		switch len(args) {
+		case 2: // GET zonetemplates <ID>
+			zt := new(nuage_v3_2.Zonetemplate)
+			zt.ID = args[1]
+			shown, err := apiget(zt)
+
+			if err != nil {
+				return "", err
+			}
+			// JSON pretty-print the zone template
+			jsonzt, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Zone Template: Name [%s] <=== \n%#s\n", zt.Name, string(jsonzt))
+			return "Zone Template Get -- done", err
+		case 3:
+			ztid := args[1]
+			switch args[2] {
+			case "subnettemplates": // GET zonetemplates <ID> subnettemplates
+				var sts nuage_v3_2.Subnettemplateslice
+				err := sts.List(myconn, ztid)
+				if err != nil {
+					return "", err
+				}
+				// Iterate through the list of subnet templates and JSON pretty-print them
+				fmt.Printf("\n ######## Subnet templates for Zone template ID: [%s] ########\n", ztid)
+				for i, v := range sts {
+					st, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Subnet template nr [%d]: Name [%s] <=== \n%s\n", i, sts[i].Name, string(st))
+				}
+
+				return "Subnet template list -- done", err
+			}
+		}
+	case "subnettemplates":
+		if len(args) != 2 {
+			return "Format:\n    GET subnettemplates <ID>", nil
+		}
+		// GET subnettemplates <ID>
+		st := new(nuage_v3_2.Subnettemplate)
+		st.ID = args[1]
+		err := st.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonst, _ := json.MarshalIndent(st, "", "\t")
+		fmt.Printf("\n ===> Subnet Template: Name [%s] <=== \n%s\n", st.Name, string(jsonst))
+		return "Subnet Template Get -- done", err
+	case "zones":
+		switch len(args) {
+		case 1: // GET zones
+			// Get list of zones with "nil" as parent domain -- i.e. global list of all zones
+			var zs nuage_v3_2.Zoneslice
+			shown, err := apilist(&zs, "")
+			if err != nil {
+				return "", err
+			}
+			// Yucky -- type cast from nuage_v3_2.Zoneslice to []nuage_v3_2.Zone
+			var zl []nuage_v3_2.Zone
+			zl = zs
+			for i := range zl {
+				jsonzone, _ := json.MarshalIndent(shown[i], "", "\t")
+				fmt.Printf("\n ===> Zone nr [%d]: Name [%s] <=== \n%#s\n", i, zl[i].Name, string(jsonzone))
+				printchannelmap("", zl[i].AssociatedMulticastChannelMapID)
+			}
+			return "Zone list -- done", err
+		case 2: // GET zones <ID>
+			// Get a specific Zone ID
+			zone := new(nuage_v3_2.Zone)
+			zone.ID = args[1]
+			shown, err := apiget(zone)
+			if err != nil {
+				return "", err
+			}
+			jsonzone, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Zone Name [%s] <=== \n%#s\n", zone.Name, string(jsonzone))
+			printchannelmap("", zone.AssociatedMulticastChannelMapID)
+			return "Zone Get -- done", err
+		}
+
+	case "subnets":
+		switch len(args) {
+		case 1: // GET subnets
+			// Get list of subnets with "nil" as parent domain -- i.e. global list of all subnets
+			var ss nuage_v3_2.Subnetslice
+			shown, err := apilist(&ss, "")
+			if err != nil {
+				return "", err
+			}
+			// Yucky -- type cast from nuage_v3_2.Subnetslice to []nuage_v3_2.Subnet
+			var za []nuage_v3_2.Subnet
+			za = ss
+			for i := range za {
+				jsonsubnet, _ := json.MarshalIndent(shown[i], "", "\t")
+				fmt.Printf("\n ===> Subnet nr [%d]: Name [%s] <=== \n%#s\n", i, za[i].Name, string(jsonsubnet))
+				printchannelmap("", za[i].AssociatedMulticastChannelMapID)
+			}
+			return "Subnet list -- done", err
+		case 2: // GET subnets <ID>
+			// Get a specific Subnet ID
+			subnet := new(nuage_v3_2.Subnet)
+			subnet.ID = args[1]
+			shown, err := apiget(subnet)
+			if err != nil {
+				return "", err
+			}
+			jsonsubnet, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> Subnet Name [%s] <=== \n%#s\n", subnet.Name, string(jsonsubnet))
+			printchannelmap("", subnet.AssociatedMulticastChannelMapID)
+			return "Subnet Get -- done", err
+
+		case 3:
+			switch args[2] {
+			case "vports": // GET subnets <ID> vports
+				subnet := new(nuage_v3_2.Subnet)
+				subnet.ID = args[1]
+				vports, shown, err := apivportslist(subnet)
+				if err != nil {
+					return "", err
+				}
+				for i := range vports {
+					jsonvport, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%#s\n", i, vports[i].Name, string(jsonvport))
+					printchannelmap("Receive", vports[i].AssociatedMulticastChannelMapID)
+					printchannelmap("Send", vports[i].AssociatedSendMulticastChannelMapID)
+				}
+				return "Subnet VPorts list -- done", err
+			case "vminterfaces": // GET subnets <ID> vminterfaces
+				var subnet nuage_v3_2.Subnet
+				subnet.ID = args[1]
+				vmis, shown, err := apivminterfaceslist(&subnet)
+				if err != nil {
+					return "", err
+				}
+				for i := range vmis {
+					jsonvmi, _ := json.MarshalIndent(shown[i], "", "\t")
+					fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%#s\n", i, vmis[i].Name, string(jsonvmi))
+				}
+				return "Subnet VMInterfaces list -- done", err
+
+			case "hostinterfaces": // GET subnets <ID> hostinterfaces
+				subnet := new(nuage_v3_2.Subnet)
+				subnet.ID = args[1]
+				his, err := subnet.HostInterfacesList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range his {
+					jsonhost, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Host interface nr [%d]: Name [%s] <=== \n%s\n", i, his[i].Name, string(jsonhost))
+				}
+				return "Subnet Host interfaces list -- done", err
+
+			case "bridgeinterfaces": // GET subnets <ID> bridgeinterfaces
+				subnet := new(nuage_v3_2.Subnet)
+				subnet.ID = args[1]
+				bis, err := subnet.BridgeInterfacesList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range bis {
+					jsonbridge, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Bridge interface nr [%d]: Name [%s] <=== \n%s\n", i, bis[i].Name, string(jsonbridge))
+				}
+				return "Subnet Bridge interfaces list -- done", err
+			}
+		}
+
+	case "ingressacltemplates":
+		switch len(args) {
+		case 2: // GET ingressacltemplates <ID>
+			acl := new(nuage_v3_2.IngressACLTemplate)
+			acl.ID = args[1]
+			err := acl.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonacl, _ := json.MarshalIndent(acl, "", "\t")
+			fmt.Printf("\n ===> Ingress ACL template: Name [%s] <=== \n%s\n", acl.Name, string(jsonacl))
+			return "Ingress ACL template Get -- done", err
+		case 3:
+			switch args[2] {
+			case "entries": // GET ingressacltemplates <ID> entries
+				var entries nuage_v3_2.IngressACLEntryslice
+				err := entries.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				for i, v := range entries {
+					jsonentry, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Ingress ACL entry nr [%d]: Priority [%d] <=== \n%s\n", i, entries[i].Priority, string(jsonentry))
+				}
+				return "Ingress ACL entries list -- done", err
+			}
+		}
+
+	case "ingressaclentries": // GET ingressaclentries <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET ingressaclentries <ID>", nil
+		}
+		entry := new(nuage_v3_2.IngressACLEntry)
+		entry.ID = args[1]
+		err := entry.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonentry, _ := json.MarshalIndent(entry, "", "\t")
+		fmt.Printf("\n ===> Ingress ACL entry: Priority [%d] <=== \n%s\n", entry.Priority, string(jsonentry))
+		return "Ingress ACL entry Get -- done", err
+
+	case "egressacltemplates":
+		switch len(args) {
+		case 2: // GET egressacltemplates <ID>
+			acl := new(nuage_v3_2.EgressACLTemplate)
+			acl.ID = args[1]
+			err := acl.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonacl, _ := json.MarshalIndent(acl, "", "\t")
+			fmt.Printf("\n ===> Egress ACL template: Name [%s] <=== \n%s\n", acl.Name, string(jsonacl))
+			return "Egress ACL template Get -- done", err
+		case 3:
+			switch args[2] {
+			case "entries": // GET egressacltemplates <ID> entries
+				var entries nuage_v3_2.EgressACLEntryslice
+				err := entries.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				for i, v := range entries {
+					jsonentry, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Egress ACL entry nr [%d]: Priority [%d] <=== \n%s\n", i, entries[i].Priority, string(jsonentry))
+				}
+				return "Egress ACL entries list -- done", err
+			}
+		}
+
+	case "egressaclentries": // GET egressaclentries <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET egressaclentries <ID>", nil
+		}
+		entry := new(nuage_v3_2.EgressACLEntry)
+		entry.ID = args[1]
+		err := entry.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonentry, _ := json.MarshalIndent(entry, "", "\t")
+		fmt.Printf("\n ===> Egress ACL entry: Priority [%d] <=== \n%s\n", entry.Priority, string(jsonentry))
+		return "Egress ACL entry Get -- done", err
+
+	case "ingressadvfwdtemplates":
+		switch len(args) {
+		case 2: // GET ingressadvfwdtemplates <ID>
+			afwd := new(nuage_v3_2.IngressAdvFwdTemplate)
+			afwd.ID = args[1]
+			err := afwd.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonafwd, _ := json.MarshalIndent(afwd, "", "\t")
+			fmt.Printf("\n ===> Ingress advanced forwarding template: Name [%s] <=== \n%s\n", afwd.Name, string(jsonafwd))
+			return "Ingress advanced forwarding template Get -- done", err
+		case 3:
+			switch args[2] {
+			case "entries": // GET ingressadvfwdtemplates <ID> entries
+				var entries nuage_v3_2.IngressAdvFwdEntryslice
+				err := entries.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				for i, v := range entries {
+					jsonentry, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Ingress advanced forwarding entry nr [%d]: Priority [%d] <=== \n%s\n", i, entries[i].Priority, string(jsonentry))
+				}
+				return "Ingress advanced forwarding entries list -- done", err
+			}
+		}
+
+	case "ingressadvfwdentries": // GET ingressadvfwdentries <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET ingressadvfwdentries <ID>", nil
+		}
+		entry := new(nuage_v3_2.IngressAdvFwdEntry)
+		entry.ID = args[1]
+		err := entry.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonentry, _ := json.MarshalIndent(entry, "", "\t")
+		fmt.Printf("\n ===> Ingress advanced forwarding entry: Priority [%d] <=== \n%s\n", entry.Priority, string(jsonentry))
+		return "Ingress advanced forwarding entry Get -- done", err
+
+	case "jobs":
+		switch len(args) {
+		case 2: // GET jobs <ID>
+			job := new(nuage_v3_2.Job)
+			job.ID = args[1]
+			err := job.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonjob, _ := json.MarshalIndent(job, "", "\t")
+			fmt.Printf("\n ===> Job: Command [%s] Status [%s] Progress [%.0f%%] <=== \n%s\n", job.Command, job.Status, 100*job.Progress, string(jsonjob))
+			return "Job Get -- done", err
+		case 3: // GET jobs <Parent type> <Parent ID>
+			var jobs nuage_v3_2.Jobslice
+			err := jobs.List(myconn, args[1], args[2])
+			if err != nil {
+				return "", err
+			}
+			fmt.Printf("\n ######## Jobs for %s ID: [%s] ########\n", args[1], args[2])
+			for i, v := range jobs {
+				jsonjob, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Job nr [%d]: Command [%s] Status [%s] <=== \n%s\n", i, jobs[i].Command, jobs[i].Status, string(jsonjob))
+			}
+			return "Job list -- done", err
+		}
+		return "Format:\n    GET jobs <ID>\n    GET jobs <Parent type> <Parent ID>", nil
+
+	case "vsps":
+		switch len(args) {
+		case 1: // GET vsps
+			var vsps nuage_v3_2.VSPslice
+			err := vsps.List(myconn)
+			if err != nil {
+				return "", err
+			}
+			for i, v := range vsps {
+				jsonvsp, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> VSP nr [%d]: Name [%s] <=== \n%s\n", i, vsps[i].Name, string(jsonvsp))
+			}
+			return "VSP list -- done", err
+		case 2: // GET vsps <ID>
+			vsp := new(nuage_v3_2.VSP)
+			vsp.ID = args[1]
+			err := vsp.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonvsp, _ := json.MarshalIndent(vsp, "", "\t")
+			fmt.Printf("\n ===> VSP: Name [%s] <=== \n%s\n", vsp.Name, string(jsonvsp))
+			return "VSP Get -- done", err
+		case 3:
+			switch args[2] {
+			case "vscs": // GET vsps <ID> vscs
+				var vscs nuage_v3_2.VSCslice
+				err := vscs.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## VSCs for VSP ID: [%s] ########\n", args[1])
+				for i, v := range vscs {
+					jsonvsc, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VSC nr [%d]: Name [%s] Status [%s] Uptime [%s] <=== \n%s\n", i, vscs[i].Name, vscs[i].Status, uptime(vscs[i].Uptime), string(jsonvsc))
+				}
+				return "VSC list -- done", err
+			}
+		}
+		return "Format:\n    GET vsps [ <ID> [ vscs ] ]", nil
+
+	case "vscs":
+		switch len(args) {
+		case 2: // GET vscs <ID>
+			vsc := new(nuage_v3_2.VSC)
+			vsc.ID = args[1]
+			err := vsc.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonvsc, _ := json.MarshalIndent(vsc, "", "\t")
+			fmt.Printf("\n ===> VSC: Name [%s] Status [%s] Uptime [%s] <=== \n%s\n", vsc.Name, vsc.Status, uptime(vsc.Uptime), string(jsonvsc))
+			return "VSC Get -- done", err
+		case 3:
+			switch args[2] {
+			case "vrss": // GET vscs <ID> vrss
+				var vrss nuage_v3_2.VRSslice
+				err := vrss.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## VRSs for VSC ID: [%s] ########\n\n", args[1])
+				printvrss(vrss)
+				return "VRS list -- done", err
+			}
+		}
+		return "Format:\n    GET vscs <ID> [ vrss ]", nil
+
+	case "vrss":
+		switch len(args) {
+		case 1: // GET vrss
+			var vrss nuage_v3_2.VRSslice
+			err := vrss.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			fmt.Println()
+			printvrss(vrss)
+			return "VRS list -- done", err
+		case 2: // GET vrss <ID>
+			vrs := new(nuage_v3_2.VRS)
+			vrs.ID = args[1]
+			err := vrs.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonvrs, _ := json.MarshalIndent(vrs, "", "\t")
+			fmt.Printf("\n ===> VRS: Name [%s] Status [%s] Uptime [%s] <=== \n%s\n", vrs.Name, vrs.Status, uptime(vrs.Uptime), string(jsonvrs))
+			return "VRS Get -- done", err
+		case 3:
+			vrs := new(nuage_v3_2.VRS)
+			vrs.ID = args[1]
+			switch args[2] {
+			case "vms": // GET vrss <ID> vms
+				vms, err := vrs.VMsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## VirtualMachines for VRS ID: [%s] ########\n", vrs.ID)
+				for i, v := range vms {
+					jsonvm, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VirtualMachine nr [%d]: Name [%s] <=== \n%s\n", i, vms[i].Name, string(jsonvm))
+				}
+				return "VRS VirtualMachines list -- done", err
+			}
+		}
+		return "Format:\n    GET vrss [ <ID> [ vms ] ]", nil
+
+	case "hypervisors": // GET hypervisors -- VirtualMachines grouped by hypervisor
+		return printhypervisors()
+
+	case "gateways":
+		switch len(args) {
+		case 1: // GET gateways
+			var gws nuage_v3_2.Gatewayslice
+			err := gws.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range gws {
+				jsongw, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Gateway nr [%d]: Name [%s] <=== \n%s\n", i, gws[i].Name, string(jsongw))
+			}
+			return "Gateway list -- done", err
+		case 2: // GET gateways <ID>
+			gw := new(nuage_v3_2.Gateway)
+			gw.ID = args[1]
+			err := gw.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsongw, _ := json.MarshalIndent(gw, "", "\t")
+			fmt.Printf("\n ===> Gateway: Name [%s] <=== \n%s\n", gw.Name, string(jsongw))
+			return "Gateway Get -- done", err
+		case 3:
+			switch args[2] {
+			case "ports": // GET gateways <ID> ports
+				var ports nuage_v3_2.Portslice
+				err := ports.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Ports for Gateway ID: [%s] ########\n", args[1])
+				for i, v := range ports {
+					jsonport, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Port nr [%d]: Name [%s] <=== \n%s\n", i, ports[i].Name, string(jsonport))
+				}
+				return "Gateway Ports list -- done", err
+
+			case "tree": // GET gateways <ID> tree -- Gateway -> Ports -> VLANs -> VPorts
+				return printgatewaytree(args[1])
+			}
+		}
+		return "Format:\n    GET gateways [ <ID> [ ports | tree ] ]", nil
+
+	case "ports":
+		switch len(args) {
+		case 2: // GET ports <ID>
+			port := new(nuage_v3_2.Port)
+			port.ID = args[1]
+			err := port.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonport, _ := json.MarshalIndent(port, "", "\t")
+			fmt.Printf("\n ===> Port: Name [%s] <=== \n%s\n", port.Name, string(jsonport))
+			return "Port Get -- done", err
+		case 3:
+			switch args[2] {
+			case "vlans": // GET ports <ID> vlans
+				var vlans nuage_v3_2.VLANslice
+				err := vlans.List(myconn, args[1])
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## VLANs for Port ID: [%s] ########\n", args[1])
+				for i, v := range vlans {
+					jsonvlan, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VLAN nr [%d]: VLAN [%d] <=== \n%s\n", i, vlans[i].Value, string(jsonvlan))
+				}
+				return "Port VLANs list -- done", err
+			}
+		}
+		return "Format:\n    GET ports <ID> [ vlans ]", nil
+
+	case "vlans":
+		switch len(args) {
+		case 2: // GET vlans <ID>
+			vlan := new(nuage_v3_2.VLAN)
+			vlan.ID = args[1]
+			err := vlan.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonvlan, _ := json.MarshalIndent(vlan, "", "\t")
+			fmt.Printf("\n ===> VLAN: VLAN [%d] <=== \n%s\n", vlan.Value, string(jsonvlan))
+			return "VLAN Get -- done", err
+		case 3:
+			switch args[2] {
+			case "vport": // GET vlans <ID> vport
+				vlan := new(nuage_v3_2.VLAN)
+				vlan.ID = args[1]
+				err := vlan.Get(myconn)
+				if err != nil {
+					return "", err
+				}
+				if vlan.VPortID == "" {
+					return "VLAN [" + strconv.Itoa(vlan.Value) + "] is not bound to any VPort", nil
+				}
+				vport := new(nuage_v3_2.VPort)
+				vport.ID = vlan.VPortID
+				err = vport.Get(myconn)
+				if err != nil {
+					return "", err
+				}
+				jsonvport, _ := json.MarshalIndent(vport, "", "\t")
+				fmt.Printf("\n ===> VPort Name [%s] Type [%s] <=== \n%s\n", vport.Name, vport.Type, string(jsonvport))
+				return "VLAN VPort Get -- done", err
+			}
+		}
+		return "Format:\n    GET vlans <ID> [ vport ]", nil
+
+	case "dhcpoptions":
+		switch len(args) {
+		case 2: // GET dhcpoptions <ID>
+			o := new(nuage_v3_2.DHCPOption)
+			o.ID = args[1]
+			err := o.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsono, _ := json.MarshalIndent(o, "", "\t")
+			fmt.Printf("\n ===> DHCP option: Type [%s] <=== \n%s\n", o.Type, string(jsono))
+			printdhcpoptions([]nuage_v3_2.DHCPOption{*o})
+			return "DHCP option Get -- done", err
+		case 3: // GET dhcpoptions <domain | zone | subnet | vport | l2domain> <Parent ID>
+			var opts nuage_v3_2.DHCPOptionslice
+			err := opts.List(myconn, args[1], args[2])
+			if err != nil {
+				return "", err
+			}
+			fmt.Printf("\n ######## DHCP options for %s ID: [%s] ########\n\n", args[1], args[2])
+			printdhcpoptions(opts)
+			return fmt.Sprintf("DHCP options list (%d options) -- done", len(opts)), err
+		}
+		return "Format:\n    GET dhcpoptions <ID>\n    GET dhcpoptions <domain | zone | subnet | vport | l2domain> <Parent ID>", nil
+
+	case "multicastchannelmaps":
+		switch len(args) {
+		case 1: // GET multicastchannelmaps
+			var cms nuage_v3_2.MulticastChannelMapslice
+			err := cms.List(myconn)
+			if err != nil {
+				return "", err
+			}
+			for _, v := range cms {
+				printchannelmap("", v.ID)
+			}
+			return "Multicast channel map list -- done", err
+		case 2: // GET multicastchannelmaps <ID>
+			cm := new(nuage_v3_2.MulticastChannelMap)
+			cm.ID = args[1]
+			err := cm.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsoncm, _ := json.MarshalIndent(cm, "", "\t")
+			fmt.Printf("\n ===> Multicast channel map: Name [%s] <=== \n%s\n", cm.Name, string(jsoncm))
+			printchannelmap("", cm.ID)
+			return "Multicast channel map Get -- done", err
+		case 3:
+			if args[2] != "ranges" {
+				break
+			}
+			// GET multicastchannelmaps <ID> ranges
+			var mrs nuage_v3_2.MulticastRangeslice
+			err := mrs.List(myconn, args[1])
+			if err != nil {
+				return "", err
+			}
+			fmt.Printf("\n ######## Multicast ranges for Channel map ID: [%s] ########\n", args[1])
+			for i, v := range mrs {
+				jsonmr, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Multicast range nr [%d]: [%s - %s] <=== \n%s\n", i, mrs[i].MinAddress, mrs[i].MaxAddress, string(jsonmr))
+			}
+			return "Multicast range list -- done", err
+		}
+		return "Format:\n    GET multicastchannelmaps [ <ID> [ ranges ] ]", nil
+
+	case "multicastlists":
+		switch len(args) {
+		case 2: // GET multicastlists <ID>
+			ml := new(nuage_v3_2.MulticastList)
+			ml.ID = args[1]
+			err := ml.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonml, _ := json.MarshalIndent(ml, "", "\t")
+			fmt.Printf("\n ===> Multicast list: ID [%s] <=== \n%s\n", ml.ID, string(jsonml))
+			printmulticastlist("", ml.ID)
+			return "Multicast list Get -- done", err
+		case 3:
+			if args[2] != "channelmaps" {
+				break
+			}
+			// GET multicastlists <ID> channelmaps
+			ml := new(nuage_v3_2.MulticastList)
+			ml.ID = args[1]
+			cms, err := ml.ChannelMapsList(myconn)
+			if err != nil {
+				return "", err
+			}
+			fmt.Printf("\n ######## Channel maps for Multicast list ID: [%s] ########\n", ml.ID)
+			for i, v := range cms {
+				jsoncm, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Channel map nr [%d]: Name [%s] <=== \n%s\n", i, cms[i].Name, string(jsoncm))
+			}
+			return "Multicast list Channel maps list -- done", err
+		}
+		return "Format:\n    GET multicastlists <ID> [ channelmaps ]\n    GET enterprises <ID> multicastlists", nil
+
+	case "qos":
+		switch len(args) {
+		case 2: // GET qos <ID>
+			q := new(nuage_v3_2.QoS)
+			q.ID = args[1]
+			err := q.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonq, _ := json.MarshalIndent(q, "", "\t")
+			fmt.Printf("\n ===> QoS: Name [%s] <=== \n%s\n", q.Name, string(jsonq))
+			return "QoS Get -- done", err
+		case 3: // GET qos <domain | zone | subnet | vport | l2domain> <Parent ID>
+			var qs nuage_v3_2.QoSslice
+			err := qs.List(myconn, args[1], args[2])
+			if err != nil {
+				return "", err
+			}
+			fmt.Printf("\n ######## QoS for %s ID: [%s] ########\n", args[1], args[2])
+			for i, v := range qs {
+				jsonq, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> QoS nr [%d]: Name [%s] <=== \n%s\n", i, qs[i].Name, string(jsonq))
+			}
+			return "QoS list -- done", err
+		}
+		return "Format:\n    GET qos <ID>\n    GET qos <domain | zone | subnet | vport | l2domain> <Parent ID>", nil
+
+	case "ratelimiters":
+		if len(args) != 2 {
+			return "Format:\n    GET ratelimiters <ID>\n    GET enterprises <ID> ratelimiters", nil
+		}
+		// GET ratelimiters <ID>
+		rl := new(nuage_v3_2.RateLimiter)
+		rl.ID = args[1]
+		err := rl.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonrl, _ := json.MarshalIndent(rl, "", "\t")
+		fmt.Printf("\n ===> Rate limiter: Name [%s] <=== \n%s\n", rl.Name, string(jsonrl))
+		return "Rate limiter Get -- done", err
+
+	case "users":
+		switch len(args) {
+		case 2: // GET users <ID>
+			u := new(nuage_v3_2.User)
+			u.ID = args[1]
+			err := u.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			// Never display passwords, not even hashed
+			u.Password = ""
+			jsonuser, _ := json.MarshalIndent(u, "", "\t")
+			fmt.Printf("\n ===> User: User name [%s] <=== \n%s\n", u.UserName, string(jsonuser))
+			groups, err := u.GroupsList(myconn)
+			if err != nil {
+				return "", err
+			}
+			var names []string
+			for _, g := range groups {
+				names = append(names, g.Name)
+			}
+			fmt.Printf("\n Member of [%d] groups: %s\n", len(groups), strings.Join(names, ", "))
+			return "User Get -- done", err
+		case 3:
+			u := new(nuage_v3_2.User)
+			u.ID = args[1]
+			switch args[2] {
+			case "groups": // GET users <ID> groups
+				groups, err := u.GroupsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Groups for User ID: [%s] ########\n", u.ID)
+				for i, v := range groups {
+					jsongroup, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Group nr [%d]: Name [%s] <=== \n%s\n", i, groups[i].Name, string(jsongroup))
+				}
+				return "User Groups list -- done", err
+			}
+		}
+
+	case "groups":
+		switch len(args) {
+		case 2: // GET groups <ID>
+			g := new(nuage_v3_2.Group)
+			g.ID = args[1]
+			err := g.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsongroup, _ := json.MarshalIndent(g, "", "\t")
+			fmt.Printf("\n ===> Group: Name [%s] <=== \n%s\n", g.Name, string(jsongroup))
+			return "Group Get -- done", err
+		case 3:
+			g := new(nuage_v3_2.Group)
+			g.ID = args[1]
+			switch args[2] {
+			case "users": // GET groups <ID> users
+				users, err := g.UsersList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Users in Group ID: [%s] ########\n", g.ID)
+				for i, v := range users {
+					// Never display passwords, not even hashed
+					v.Password = ""
+					jsonuser, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> User nr [%d]: User name [%s] <=== \n%s\n", i, users[i].UserName, string(jsonuser))
+				}
+				return "Group Users list -- done", err
+			}
+		}
+
+	case "networkmacros":
+		switch len(args) {
+		case 2: // GET networkmacros <ID>
+			en := new(nuage_v3_2.EnterpriseNetwork)
+			en.ID = args[1]
+			err := en.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonen, _ := json.MarshalIndent(en, "", "\t")
+			fmt.Printf("\n ===> Network macro: Name [%s] Network [%s] <=== \n%s\n", en.Name, tocidr(en.Address, en.Netmask), string(jsonen))
+			return "Network macro Get -- done", err
+		case 3:
+			en := new(nuage_v3_2.EnterpriseNetwork)
+			en.ID = args[1]
+			switch args[2] {
+			case "networkmacrogroups": // GET networkmacros <ID> networkmacrogroups
+				nmgs, err := en.NetworkMacroGroupsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Network macro groups containing Network macro ID: [%s] ########\n", en.ID)
+				for i, v := range nmgs {
+					jsonnmg, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Network macro group nr [%d]: Name [%s] <=== \n%s\n", i, nmgs[i].Name, string(jsonnmg))
+				}
+				return "Network macro group list -- done", err
+			}
+		}
+
+	case "networkmacrogroups":
+		switch len(args) {
+		case 2: // GET networkmacrogroups <ID>
+			nmg := new(nuage_v3_2.NetworkMacroGroup)
+			nmg.ID = args[1]
+			err := nmg.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonnmg, _ := json.MarshalIndent(nmg, "", "\t")
+			fmt.Printf("\n ===> Network macro group: Name [%s] <=== \n%s\n", nmg.Name, string(jsonnmg))
+			return "Network macro group Get -- done", err
+		case 3:
+			nmg := new(nuage_v3_2.NetworkMacroGroup)
+			nmg.ID = args[1]
+			switch args[2] {
+			case "networkmacros": // GET networkmacrogroups <ID> networkmacros
+				ens, err := nmg.EnterpriseNetworksList(myconn)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range ens {
+					jsonen, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Network macro nr [%d]: Name [%s] Network [%s] <=== \n%s\n", i, ens[i].Name, tocidr(ens[i].Address, ens[i].Netmask), string(jsonen))
+				}
+				return "Network macro group members list -- done", err
+			}
+		}
+
+	case "staticroutes": // GET staticroutes <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET staticroutes <ID>", nil
+		}
+		sr := new(nuage_v3_2.StaticRoute)
+		sr.ID = args[1]
+		err := sr.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonsr, _ := json.MarshalIndent(sr, "", "\t")
+		fmt.Printf("\n ===> Static route: [%s/%s] via [%s] <=== \n%s\n", sr.Address, sr.Netmask, sr.NextHopIp, string(jsonsr))
+		return "Static route Get -- done", err
+
+	case "fippools":
+		switch len(args) {
+		case 1: // GET fippools
+			// Shared network resources of type "FLOATING"
+			var pools nuage_v3_2.SharedNetworkResourceslice
+			err := pools.List(myconn, "FLOATING")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range pools {
+				jsonpool, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> FIP pool nr [%d]: Name [%s] <=== \n%s\n", i, pools[i].Name, string(jsonpool))
+			}
+			return "FIP pool list -- done", err
+		case 2: // GET fippools <ID>
+			pool := new(nuage_v3_2.SharedNetworkResource)
+			pool.ID = args[1]
+			err := pool.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonpool, _ := json.MarshalIndent(pool, "", "\t")
+			fmt.Printf("\n ===> FIP pool: Name [%s] <=== \n%s\n", pool.Name, string(jsonpool))
+			return "FIP pool Get -- done", err
+		}
+
+	case "sharednetworkresources":
+		switch len(args) {
+		case 1: // GET sharednetworkresources
+			var snrs nuage_v3_2.SharedNetworkResourceslice
+			err := snrs.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range snrs {
+				jsonsnr, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Shared network resource nr [%d]: Name [%s] Type [%s] <=== \n%s\n", i, snrs[i].Name, snrs[i].Type, string(jsonsnr))
+			}
+			return "Shared network resource list -- done", err
+		case 2: // GET sharednetworkresources <ID>
+			snr := new(nuage_v3_2.SharedNetworkResource)
+			snr.ID = args[1]
+			err := snr.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonsnr, _ := json.MarshalIndent(snr, "", "\t")
+			fmt.Printf("\n ===> Shared network resource: Name [%s] <=== \n%s\n", snr.Name, string(jsonsnr))
+			return "Shared network resource Get -- done", err
+		}
+
+	case "floatingips":
+		switch len(args) {
+		case 1: // GET floatingips
+			var fips nuage_v3_2.FloatingIPslice
+			err := fips.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range fips {
+				jsonfip, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Floating IP nr [%d]: Address [%s] <=== \n%s\n", i, fips[i].Address, string(jsonfip))
+			}
+			return "Floating IP list -- done", err
+		case 2: // GET floatingips <ID>
+			fip := new(nuage_v3_2.FloatingIP)
+			fip.ID = args[1]
+			err := fip.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonfip, _ := json.MarshalIndent(fip, "", "\t")
+			fmt.Printf("\n ===> Floating IP: Address [%s] <=== \n%s\n", fip.Address, string(jsonfip))
+			return "Floating IP Get -- done", err
+		}
+
+	case "policygroups":
+		switch len(args) {
+		case 1: // GET policygroups
+			// Global list of all policy groups
+			var pgs nuage_v3_2.PolicyGroupslice
+			err := pgs.List(myconn, "")
+			if err != nil {
+				return "", err
+			}
+			for i, v := range pgs {
+				jsonpg, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> Policy group nr [%d]: Name [%s] <=== \n%s\n", i, pgs[i].Name, string(jsonpg))
+			}
+			return "Policy group list -- done", err
+		case 2: // GET policygroups <ID>
+			pg := new(nuage_v3_2.PolicyGroup)
+			pg.ID = args[1]
+			err := pg.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonpg, _ := json.MarshalIndent(pg, "", "\t")
+			fmt.Printf("\n ===> Policy group: Name [%s] <=== \n%s\n", pg.Name, string(jsonpg))
+			return "Policy group Get -- done", err
+		case 3:
+			pg := new(nuage_v3_2.PolicyGroup)
+			pg.ID = args[1]
+			switch args[2] {
+			case "vports": // GET policygroups <ID> vports
+				vports, err := pg.VPortsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Members of Policy group ID: [%s] ########\n", pg.ID)
+				for i, v := range vports {
+					jsonvport, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%s\n", i, vports[i].Name, string(jsonvport))
+					printchannelmap("Receive", vports[i].AssociatedMulticastChannelMapID)
+					printchannelmap("Send", vports[i].AssociatedSendMulticastChannelMapID)
+				}
+				return "Policy group VPorts list -- done", err
+			}
+		}
+
+	case "policygrouptemplates": // GET policygrouptemplates <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET policygrouptemplates <ID>", nil
+		}
+		pgt := new(nuage_v3_2.PolicyGrouptemplate)
+		pgt.ID = args[1]
+		err := pgt.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonpgt, _ := json.MarshalIndent(pgt, "", "\t")
+		fmt.Printf("\n ===> Policy group template: Name [%s] <=== \n%s\n", pgt.Name, string(jsonpgt))
+		return "Policy group template Get -- done", err
+
+	case "vports":
+		switch len(args) {
+		case 2: // GET vports <ID>
+			vport := new(nuage_v3_2.VPort)
+			vport.ID = args[1]
+			shown, err := apiget(vport)
+			if err != nil {
+				return "", err
+			}
+			jsonvport, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> VPort Name [%s] <=== \n%#s\n", vport.Name, string(jsonvport))
+			printchannelmap("Receive", vport.AssociatedMulticastChannelMapID)
+			printchannelmap("Send", vport.AssociatedSendMulticastChannelMapID)
+			printmultinicvport(vport.MultiNICVPortID)
+			return "VPort Get -- done", err
+		case 3:
+			vport := new(nuage_v3_2.VPort)
+			vport.ID = args[1]
+			switch args[2] {
+			case "policygroups": // GET vports <ID> policygroups
+				pgs, err := vport.PolicyGroupsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Policy groups for VPort ID: [%s] ########\n", vport.ID)
+				for i, v := range pgs {
+					jsonpg, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Policy group nr [%d]: Name [%s] <=== \n%s\n", i, pgs[i].Name, string(jsonpg))
+				}
+				return "VPort Policy groups list -- done", err
+
+			case "dhcpoptions": // GET vports <ID> dhcpoptions -- effective, i.e. including the inherited ones
+				opts, err := vport.EffectiveDHCPOptions(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Effective DHCP options for VPort ID: [%s] ########\n\n", vport.ID)
+				printdhcpoptions(opts)
+				return fmt.Sprintf("VPort DHCP options list (%d options) -- done", len(opts)), err
+
+			case "qos": // GET vports <ID> qos -- effective, i.e. the one in force, possibly inherited
+				q, err := vport.EffectiveQoS(myconn)
+				if err != nil {
+					return "", err
+				}
+				if q == nil {
+					return "No QoS for VPort ID: [" + vport.ID + "] -- not rate limited", nil
+				}
+				jsonq, _ := json.MarshalIndent(q, "", "\t")
+				fmt.Printf("\n ===> Effective QoS for VPort ID: [%s]: Name [%s], from %s ID: [%s] <=== \n%s\n", vport.ID, q.Name, q.ParentType, q.ParentID, string(jsonq))
+				printqos(*q)
+				return "VPort QoS Get -- done", err
+
+			case "hostinterfaces": // GET vports <ID> hostinterfaces
+				var his nuage_v3_2.HostInterfaceslice
+				err := his.List(myconn, vport.ID)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range his {
+					jsonhost, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Host interface nr [%d]: Name [%s] <=== \n%s\n", i, his[i].Name, string(jsonhost))
+				}
+				return "VPort Host interfaces list -- done", err
+
+			case "bridgeinterfaces": // GET vports <ID> bridgeinterfaces
+				var bis nuage_v3_2.BridgeInterfaceslice
+				err := bis.List(myconn, vport.ID)
+				if err != nil {
+					return "", err
+				}
+				for i, v := range bis {
+					jsonbridge, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Bridge interface nr [%d]: Name [%s] <=== \n%s\n", i, bis[i].Name, string(jsonbridge))
+				}
+				return "VPort Bridge interfaces list -- done", err
+
+			case "redirectiontargets": // GET vports <ID> redirectiontargets
+				rts, err := vport.RedirectionTargetsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Redirection targets for VPort ID: [%s] ########\n", vport.ID)
+				for i, v := range rts {
+					jsonrt, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Redirection target nr [%d]: Name [%s] <=== \n%s\n", i, rts[i].Name, string(jsonrt))
+				}
+				return "VPort Redirection targets list -- done", err
+
+			case "virtualips": // GET vports <ID> virtualips
+				var vips nuage_v3_2.VirtualIPslice
+				err := vips.List(myconn, vport.ID)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Virtual IPs for VPort ID: [%s] ########\n", vport.ID)
+				for i, v := range vips {
+					jsonvip, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Virtual IP nr [%d]: Address [%s] <=== \n%s\n", i, vips[i].VirtualIP, string(jsonvip))
+				}
+				return "VPort Virtual IPs list -- done", err
+			}
+		}
+		return "Format:\n    GET vports <ID> [ policygroups | dhcpoptions | qos | hostinterfaces | bridgeinterfaces | redirectiontargets | virtualips ]", nil
+
+	case "multinicvports":
+		switch len(args) {
+		case 2: // GET multinicvports <ID>
+			mnv := new(nuage_v3_2.MultiNICVPort)
+			mnv.ID = args[1]
+			err := mnv.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonmnv, _ := json.MarshalIndent(mnv, "", "\t")
+			fmt.Printf("\n ===> Multi-NIC VPort: Name [%s] <=== \n%s\n", mnv.Name, string(jsonmnv))
+			printmultinicvport(mnv.ID)
+			return "Multi-NIC VPort Get -- done", err
+		case 3:
+			if args[2] != "vports" {
+				break
+			}
+			// GET multinicvports <ID> vports
+			mnv := new(nuage_v3_2.MultiNICVPort)
+			mnv.ID = args[1]
+			vports, err := mnv.VPortsList(myconn)
+			if err != nil {
+				return "", err
+			}
+			fmt.Printf("\n ######## VPorts attached to Multi-NIC VPort ID: [%s] ########\n", mnv.ID)
+			for i, v := range vports {
+				jsonvport, _ := json.MarshalIndent(v, "", "\t")
+				fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%s\n", i, vports[i].Name, string(jsonvport))
+				printchannelmap("Receive", vports[i].AssociatedMulticastChannelMapID)
+				printchannelmap("Send", vports[i].AssociatedSendMulticastChannelMapID)
+			}
+			return "Multi-NIC VPort VPorts list -- done", err
+		}
+		return "Format:\n    GET multinicvports <ID> [ vports ]\n    GET enterprises <ID> multinicvports", nil
+
+	case "applications":
+		switch len(args) {
+		case 2: // GET applications <ID>
+			app := new(nuage_v3_2.Application)
+			app.ID = args[1]
+			err := app.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonapp, _ := json.MarshalIndent(app, "", "\t")
+			fmt.Printf("\n ===> Application: Name [%s] <=== \n%s\n", app.Name, string(jsonapp))
+			return "Application Get -- done", err
+		case 3:
+			app := new(nuage_v3_2.Application)
+			app.ID = args[1]
+			switch args[2] {
+			case "tiers": // GET applications <ID> tiers
+				tiers, err := app.TiersList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Tiers for Application ID: [%s] ########\n", app.ID)
+				for i, v := range tiers {
+					jsontier, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Tier nr [%d]: Name [%s] <=== \n%s\n", i, tiers[i].Name, string(jsontier))
+				}
+				return "Application Tiers list -- done", err
+
+			case "flows": // GET applications <ID> flows
+				flows, err := app.FlowsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## Flows for Application ID: [%s] ########\n", app.ID)
+				for i, v := range flows {
+					jsonflow, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> Flow nr [%d]: Name [%s] <=== \n%s\n", i, flows[i].Name, string(jsonflow))
+				}
+				return "Application Flows list -- done", err
+
+			case "graph": // GET applications <ID> graph -- Tiers, where they are bound, and the Flows between them
+				return printappgraph(app.ID)
+			}
+		}
+		return "Format:\n    GET applications <ID> [ tiers | flows | graph ]", nil
+
+	case "tiers":
+		if len(args) != 2 {
+			return "Format:\n    GET tiers <ID>", nil
+		}
+		// GET tiers <ID>
+		t := new(nuage_v3_2.Tier)
+		t.ID = args[1]
+		err := t.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsontier, _ := json.MarshalIndent(t, "", "\t")
+		fmt.Printf("\n ===> Tier: Name [%s] <=== \n%s\n", t.Name, string(jsontier))
+		return "Tier Get -- done", err
+
+	case "flows":
+		if len(args) != 2 {
+			return "Format:\n    GET flows <ID>", nil
+		}
+		// GET flows <ID>
+		f := new(nuage_v3_2.Flow)
+		f.ID = args[1]
+		err := f.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonflow, _ := json.MarshalIndent(f, "", "\t")
+		fmt.Printf("\n ===> Flow: Name [%s] <=== \n%s\n", f.Name, string(jsonflow))
+		return "Flow Get -- done", err
+
+	case "applicationservices":
+		if len(args) != 2 {
+			return "Format:\n    GET applicationservices <ID>\n    GET enterprises <ID> applicationservices\n    GET domains <ID> applicationservices", nil
+		}
+		// GET applicationservices <ID>
+		as := new(nuage_v3_2.ApplicationService)
+		as.ID = args[1]
+		err := as.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonas, _ := json.MarshalIndent(as, "", "\t")
+		fmt.Printf("\n ===> Application service: Name [%s] <=== \n%s\n", as.Name, string(jsonas))
+		return "Application service Get -- done", err
+
+	case "redirectiontargets":
+		switch len(args) {
+		case 2: // GET redirectiontargets <ID>
+			rt := new(nuage_v3_2.RedirectionTarget)
+			rt.ID = args[1]
+			err := rt.Get(myconn)
+			if err != nil {
+				return "", err
+			}
+			jsonrt, _ := json.MarshalIndent(rt, "", "\t")
+			fmt.Printf("\n ===> Redirection target: Name [%s] <=== \n%s\n", rt.Name, string(jsonrt))
+			return "Redirection target Get -- done", err
+		case 3:
+			rt := new(nuage_v3_2.RedirectionTarget)
+			rt.ID = args[1]
+			switch args[2] {
+			case "vports": // GET redirectiontargets <ID> vports -- the VPorts covered by this Redirection target
+				vports, err := rt.VPortsList(myconn)
+				if err != nil {
+					return "", err
+				}
+				fmt.Printf("\n ######## VPorts covered by Redirection target ID: [%s] ########\n", rt.ID)
+				for i, v := range vports {
+					jsonvport, _ := json.MarshalIndent(v, "", "\t")
+					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%s\n", i, vports[i].Name, string(jsonvport))
+					printchannelmap("Receive", vports[i].AssociatedMulticastChannelMapID)
+					printchannelmap("Send", vports[i].AssociatedSendMulticastChannelMapID)
+				}
+				return "Redirection target VPorts list -- done", err
+			}
+		}
+		return "Format:\n    GET redirectiontargets <ID> [ vports ]", nil
+
+	case "virtualips": // GET virtualips <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET virtualips <ID>", nil
+		}
+		vip := new(nuage_v3_2.VirtualIP)
+		vip.ID = args[1]
+		err := vip.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonvip, _ := json.MarshalIndent(vip, "", "\t")
+		fmt.Printf("\n ===> Virtual IP: Address [%s] <=== \n%s\n", vip.VirtualIP, string(jsonvip))
+		return "Virtual IP Get -- done", err
+
+	case "hostinterfaces": // GET hostinterfaces <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET hostinterfaces <ID>", nil
+		}
+		hi := new(nuage_v3_2.HostInterface)
+		hi.ID = args[1]
+		err := hi.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonhost, _ := json.MarshalIndent(hi, "", "\t")
+		fmt.Printf("\n ===> Host interface: Name [%s] <=== \n%s\n", hi.Name, string(jsonhost))
+		return "Host interface Get -- done", err
+
+	case "bridgeinterfaces": // GET bridgeinterfaces <ID>
+		if len(args) != 2 {
+			return "Format:\n    GET bridgeinterfaces <ID>", nil
+		}
+		bi := new(nuage_v3_2.BridgeInterface)
+		bi.ID = args[1]
+		err := bi.Get(myconn)
+		if err != nil {
+			return "", err
+		}
+		jsonbridge, _ := json.MarshalIndent(bi, "", "\t")
+		fmt.Printf("\n ===> Bridge interface: Name [%s] <=== \n%s\n", bi.Name, string(jsonbridge))
+		return "Bridge interface Get -- done", err
+
+	case "vminterfaces":
+		switch len(args) {
+		case 1: // GET vminterfaces
+			var vmis nuage_v3_2.VMInterfaceslice
+			shown, err := apilist(&vmis, "")
+			if err != nil {
+				return "", err
+			}
+			// Yucky -- type cast from nuage_v3_2.VMInterfaceslice to []nuage_v3_2.VMInterface
+			var vmia []nuage_v3_2.VMInterface
+			vmia = vmis
+			for i := range vmia {
+				jsonvminterface, _ := json.MarshalIndent(shown[i], "", "\t")
+				fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%#s\n", i, vmia[i].Name, string(jsonvminterface))
+			}
+			return "VMinterfaces list -- done", err
+
+		case 2: // GET vminterfaces <ID>
+			var vminterface nuage_v3_2.VMInterface
+			vminterface.ID = args[1]
+			shown, err := apiget(&vminterface)
+			if err != nil {
+				return "", err
+			}
+			jsonvminterface, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> VMinterface Name [%s] <=== \n%#s\n", vminterface.Name, string(jsonvminterface))
+			return "VMinterface Get -- done", err
+		}
+
+	case "vms":
+		switch len(args) {
+		case 1: // GET vms
+			var vms nuage_v3_2.VirtualMachineslice
+			shown, err := apilist(&vms, "")
+			if err != nil {
+				return "", err
+			}
+			// Yucky -- type cast from nuage_v3_2.VirtualMachineslice to []nuage_v3_2.VirtualMachine
+			var vma []nuage_v3_2.VirtualMachine
+			vma = vms
+			for i := range vma {
+				jsonvm, _ := json.MarshalIndent(shown[i], "", "\t")
+				fmt.Printf("\n ===> VirtualMachine nr [%d]: Name [%s] <=== \n%#s\n", i, vma[i].Name, string(jsonvm))
+			}
+			return "VirtualMachine list -- done", err
+
+		case 2: // GET vms <ID>
+			var vm nuage_v3_2.VirtualMachine
+			vm.ID = args[1]
+			shown, err := apiget(&vm)
+			if err != nil {
+				return "", err
+			}
+			jsonvm, _ := json.MarshalIndent(shown, "", "\t")
+			fmt.Printf("\n ===> VirtualMachine Name [%s] <=== \n%#s\n", vm.Name, string(jsonvm))
+			return "Virtual Machine Get -- done", err
+		}
+
+	default:
+		// Unknown entity request
+		break
+	}
+	return "Don't know how to process Nuage API entity: " + strings.Join(args, " "), nil
+}
+
+////////
+//////// Multi-NIC VPorts: auxiliary functions
+////////
+
+// Resolve a Multi-NIC VPort ID to its name and member VPorts. Nothing if the ID is empty. Over API v3.2 (see "v3_2conn")
+func printmultinicvport(mnvid string) {
+	if mnvid == "" {
+		return
+	}
+
+	mnv := new(nuage_v3_2.MultiNICVPort)
+	mnv.ID = mnvid
+	if err := mnv.Get(v3_2conn()); err != nil {
+		fmt.Printf("\n Multi-NIC VPort: ID [%s] -- unable to resolve: %s\n", mnvid, err)
+		return
+	}
+
+	vports, err := mnv.VPortsList(v3_2conn())
+	if err != nil {
+		fmt.Printf("\n Multi-NIC VPort: Name [%s] ID [%s] -- unable to obtain member VPorts: %s\n", mnv.Name, mnv.ID, err)
+		return
+	}
+
+	fmt.Printf("\n Multi-NIC VPort: Name [%s] ID [%s] with [%d] member VPorts\n", mnv.Name, mnv.ID, len(vports))
+	for _, vp := range vports {
+		fmt.Printf("    Name [%s] ID [%s] Type [%s] Operational state [%s]\n", vp.Name, vp.ID, vp.Type, vp.OperationalState)
+	}
+}
+
+////////
+//////// Application designer: auxiliary functions
+////////
 
-			switch child {
-			case "domaintemplates": // GET enterprises <ID> domaintemplates
+func printappservices(ass []nuage_v3_2.ApplicationService) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "\nNAME\tPROTOCOL\tSRC PORT\tDST PORT\tID")
+	for _, as := range ass {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", as.Name, as.Protocol, as.SourcePort, as.DestinationPort, as.ID)
+	}
+	w.Flush()
+}
 
-				// Get list of domain templates for that org
-				var dts nuage_v3_2.Domaintemplateslice
-				err := dts.List(myconn, entityid)
-				if err != nil {
-					return "", err
-				}
-				// Yucky -- type cast from nuage_v3_2.Domaintemplateslice to []nuage_v3_2.Domaintemplate
-				var dtl []nuage_v3_2.Domaintemplate
-				dtl = dts
-				// Iterate through the list of domain templates and JSON pretty-print them
-				fmt.Printf("\n ######## Domain templates for Enterprise ID: [%s] ########\n", entityid)
-				for i, v := range dtl {
-					dt, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> Domain template nr [%d]: Name [%s] <=== \n%#s\n", i, dtl[i].Name, string(dt))
-				}
+// Print an Application as a graph: Its Tiers, with the Zones / Subnets they are bound to, and the Flows between them
+func printappgraph(appid string) (string, error) {
+	app := new(nuage_v3_2.Application)
+	app.ID = appid
+	if err := app.Get(myconn); err != nil {
+		return "", err
+	}
 
-				return "Domain template list -- done", err
+	tiers, err := app.TiersList(myconn)
+	if err != nil {
+		return "", err
+	}
 
-			case "domains": // GET enterprises <ID> domains
-				// Get list of domains for the Enterprise ID
+	flows, err := app.FlowsList(myconn)
+	if err != nil {
+		return "", err
+	}
 
-				var ds nuage_v3_2.Domainslice
-				err := ds.List(myconn, entityid)
-				if err != nil {
-					return "", err
-				}
-				// Yucky -- type cast from nuage_v3_2.Domainslice to []nuage_v3_2.Domain
-				var dl []nuage_v3_2.Domain
-				dl = ds
-				// Iterate through the list of domains and JSON pretty-print them
-				fmt.Printf("\n ######## Domains for Enterprise ID: [%s] ########\n", entityid)
-				for i, v := range dl {
-					domain, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> Domain nr [%d]: Name [%s] <=== \n%#s\n", i, dl[i].Name, string(domain))
-				}
+	// Where each Tier is bound: Walk the Zones of the Domain, and their Subnets
+	bindings := make(map[string][]string)
 
-				return "Domain list -- done", err
+	var zs nuage_v3_2.Zoneslice
+	if err := zs.List(myconn, app.ParentID); err != nil {
+		return "", err
+	}
+	for _, z := range zs {
+		if z.AssociatedApplicationID == app.ID {
+			bindings[z.AssociatedApplicationObjectID] = append(bindings[z.AssociatedApplicationObjectID], "zone "+z.Name)
+		}
+		var ss nuage_v3_2.Subnetslice
+		if err := ss.List(myconn, z.ID); err != nil {
+			return "", err
+		}
+		for _, s := range ss {
+			if s.AssociatedApplicationID == app.ID {
+				bindings[s.AssociatedApplicationObjectID] = append(bindings[s.AssociatedApplicationObjectID], "subnet "+s.Name+" ("+tocidr(s.Address, s.Netmask)+")")
 			}
 		}
-	case "domaintemplates":
-		switch len(args) {
-		case 2: // GET domaintemplates <ID>
-			dt := new(nuage_v3_2.Domaintemplate)
-			dt.ID = args[1]
-			err := dt.Get(myconn)
+	}
 
-			if err != nil {
-				return "", err
-			}
-			// JSON pretty-print the domain template
-			jsondt, _ := json.MarshalIndent(dt, "", "\t")
-			fmt.Printf("\n ===> Domain Template: Name [%s] <=== \n%#s\n", dt.Name, string(jsondt))
-			return "Domain Template Get -- done", err
-		case 3:
-			dtid := args[1]
-			child := args[2]
-			switch child {
-			case "zonetemplates": // GET domaintemplates <ID> zonetemplates
-				var zts nuage_v3_2.Zonetemplateslice
-				err := zts.List(myconn, dtid)
-				if err != nil {
-					return "", err
-				}
-				// Yucky -- type cast from nuage_v3_2.Zonetemplateslice to []nuage_v3_2.Zonetemplate
-				var zta []nuage_v3_2.Zonetemplate
-				zta = zts
-				// Iterate through the list of zone templates and JSON pretty-print them
-				fmt.Printf("\n ######## Zone templates for Domain template ID: [%s] ########\n", dtid)
-				for i, v := range zta {
-					zt, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> Zone template nr [%d]: Name [%s] <=== \n%#s\n", i, zta[i].Name, string(zt))
-				}
+	names := make(map[string]string)
+	fmt.Printf("\n Application [%s] ID [%s] in Domain ID [%s]\n", app.Name, app.ID, app.ParentID)
+	fmt.Printf("\n Tiers:\n")
+	for _, t := range tiers {
+		names[t.ID] = t.Name
+		detail := t.Type
+		switch {
+		case t.Type == "NETWORK_MACRO":
+			detail += " " + t.AssociatedNetworkMacroID
+		case t.Address != "":
+			detail += " " + tocidr(t.Address, t.Netmask)
+		}
+		bound := "not bound"
+		if len(bindings[t.ID]) > 0 {
+			bound = "bound to: " + strings.Join(bindings[t.ID], ", ")
+		}
+		fmt.Printf("    [%s] %s -- %s\n", t.Name, detail, bound)
+	}
 
-				return "Zone template list -- done", err
+	fmt.Printf("\n Flows:\n")
+	services := make(map[string]string)
+	for _, f := range flows {
+		service := "all traffic"
+		if id := f.AssociatedApplicationServiceID; id != "" {
+			if _, found := services[id]; !found {
+				as := new(nuage_v3_2.ApplicationService)
+				as.ID = id
+				if err := as.Get(myconn); err != nil {
+					services[id] = id
+				} else {
+					services[id] = as.Name + " (" + as.Protocol + "/" + as.DestinationPort + ")"
+				}
 			}
+			service = services[id]
+		}
+		fmt.Printf("    [%s] --> [%s] : %s   (Flow [%s])\n", tiername(names, f.OriginTierID), tiername(names, f.DestinationTierID), service, f.Name)
+	}
+
+	return fmt.Sprintf("Application graph (%d tiers, %d flows) -- done", len(tiers), len(flows)), nil
+}
+
+// Tier name by ID, or the ID itself if it's not a Tier of this Application
+func tiername(names map[string]string, id string) string {
+	if name, found := names[id]; found {
+		return name
+	}
+	return id
+}
+
+////////
+//////// Alarms and Event logs: auxiliary functions
+////////
+
+type alarmsbytime nuage_v3_2.Alarmslice
+
+func (a alarmsbytime) Len() int           { return len(a) }
+func (a alarmsbytime) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a alarmsbytime) Less(i, j int) bool { return a[i].Timestamp > a[j].Timestamp }
+
+type eventsbytime nuage_v3_2.EventLogslice
 
+func (e eventsbytime) Len() int           { return len(e) }
+func (e eventsbytime) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e eventsbytime) Less(i, j int) bool { return e[i].EventReceivedTime > e[j].EventReceivedTime }
+
+func printalarms(alarms []nuage_v3_2.Alarm) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "\nTIME\tSEVERITY\tACK\tCOUNT\tTITLE\tTARGET\tID")
+	for _, a := range alarms {
+		ack := ""
+		if a.Acknowledged {
+			ack = "yes"
 		}
-	case "domains":
-		switch len(args) {
-		case 1: // GET domains
-			// Get list of domains with "nil" as parent enterprise -- i.e. global list of all domains
-			var ds nuage_v3_2.Domainslice
-			err := ds.List(myconn, "")
-			if err != nil {
-				return "", err
-			}
-			// Yucky -- type cast from nuage_v3_2.Domainslice to []nuage_v3_2.Domain
-			var dl []nuage_v3_2.Domain
-			dl = ds
-			for i, v := range dl {
-				jsondomain, _ := json.MarshalIndent(v, "", "\t")
-				fmt.Printf("\n ===> Domain nr [%d]: Name [%s] <=== \n%#s\n", i, dl[i].Name, string(jsondomain))
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n", a.Time().Format("2006-01-02 15:04:05"), a.Severity, ack, a.NumberOfOccurances, a.Title, a.TargetObject, a.ID)
+	}
+	w.Flush()
+}
+
+////////
+//////// Tags: auxiliary functions
+////////
+
+// Print the tags (Metadata) of an entity as "key=value", global ones marked as such
+func printtags(mds []nuage_v3_2.Metadata) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "\nKEY\tVALUE\tGLOBAL\tID")
+	for _, md := range mds {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\n", md.Name, md.Blob, md.Global, md.ID)
+	}
+	w.Flush()
+}
+
+// Entities that can be looked up by tag: As named for GET (plural) -> VSD entity type (singular), e.g. "ingressaclentries" -> "ingressaclentrytemplate"
+var taggable = map[string]string{
+	"enterprises":            "enterprise",
+	"domains":                "domain",
+	"domaintemplates":        "domaintemplate",
+	"zones":                  "zone",
+	"zonetemplates":          "zonetemplate",
+	"subnets":                "subnet",
+	"subnettemplates":        "subnettemplate",
+	"l2domains":              "l2domain",
+	"l2domaintemplates":      "l2domaintemplate",
+	"vports":                 "vport",
+	"vms":                    "vm",
+	"vminterfaces":           "vminterface",
+	"users":                  "user",
+	"groups":                 "group",
+	"gateways":               "gateway",
+	"floatingips":            "floatingip",
+	"staticroutes":           "staticroute",
+	"redirectiontargets":     "redirectiontarget",
+	"multicastlists":         "multicastlist",
+	"networkmacros":          "enterprisenetwork",
+	"networkmacrogroups":     "networkmacrogroup",
+	"policygroups":           "policygroup",
+	"policygrouptemplates":   "policygrouptemplate",
+	"ingressacltemplates":    "ingressacltemplate",
+	"egressacltemplates":     "egressacltemplate",
+	"ingressaclentries":      "ingressaclentrytemplate",
+	"egressaclentries":       "egressaclentrytemplate",
+	"ingressadvfwdtemplates": "ingressadvfwdtemplate",
+	"ingressadvfwdentries":   "ingressadvfwdentrytemplate",
+}
+
+// The taggable entities, as named for GET, sorted
+func taggablelist() string {
+	var known []string
+	for e := range taggable {
+		known = append(known, e)
+	}
+	sort.Strings(known)
+	return strings.Join(known, " ")
+}
+
+// GET <entities> --tag <key>[=<value>] . Entities in plural, as for GET, e.g. "domains"
+func gettagged(entities, tag string) (string, error) {
+	entitytype, found := taggable[entities]
+	if !found {
+		return "Don't know how to find tagged Nuage API entities: [" + entities + "]. Known: " + taggablelist(), nil
+	}
+
+	key, value := tag, ""
+	if i := strings.Index(tag, "="); i >= 0 {
+		key, value = tag[:i], tag[i+1:]
+	}
+
+	if key == "" {
+		return "'" + tag + "'" + " is not a valid tag, expecting <key>[=<value>]", nil
+	}
+
+	tagged, err := nuage_v3_2.FindTagged(myconn, entitytype, key, value)
+	if err != nil {
+		return "", err
+	}
+
+	for i, te := range tagged {
+		fmt.Printf("\n ===> Tagged %s nr [%d]: Name [%s] ID [%s] <=== ", te.Type, i, te.Name, te.ID)
+		printtags(te.Metadata)
+	}
+
+	return "Tagged " + entities + " list -- done", nil
+}
+
+////////
+//////// Statistics: auxiliary functions
+////////
+
+// Metric names, in a stable order: The default ones first, then any others
+func statsmetrics(st nuage_v3_2.Statistics) []string {
+	var names []string
+	for _, m := range nuage_v3_2.StatisticsMetrics {
+		if _, found := st.Stats[m]; found {
+			names = append(names, m)
+		}
+	}
+	var others []string
+	for m := range st.Stats {
+		known := false
+		for _, n := range names {
+			if n == m {
+				known = true
+				break
 			}
-			return "Domain list -- done", err
-		case 2: // GET domains <ID>
-			// Get a specific Domain ID
-			domain := new(nuage_v3_2.Domain)
-			domain.ID = args[1]
-			err := domain.Get(myconn)
-			if err != nil {
-				return "", err
+		}
+		if !known {
+			others = append(others, m)
+		}
+	}
+	sort.Strings(others)
+	return append(names, others...)
+}
+
+// Statistics as a table: One row per data point, one column per metric
+func printstatstable(st nuage_v3_2.Statistics) {
+	metrics := statsmetrics(st)
+
+	points := 0
+	for _, m := range metrics {
+		if len(st.Stats[m]) > points {
+			points = len(st.Stats[m])
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "TIME\t%s\t\n", strings.Join(metrics, "\t"))
+	for i := 0; i < points; i++ {
+		row := []string{st.Timestamp(i).Format("15:04:05")}
+		for _, m := range metrics {
+			value := "-"
+			if i < len(st.Stats[m]) {
+				value = strconv.FormatFloat(st.Stats[m][i], 'f', 0, 64)
 			}
-			jsondomain, _ := json.MarshalIndent(domain, "", "\t")
-			fmt.Printf("\n ===> Domain Name [%s] <=== \n%#s\n", domain.Name, string(jsondomain))
-			return "Domain Get -- done", err
-		case 3:
-			switch args[2] {
-			case "vports": // GET domains <ID> vports
-				var vports []nuage_v3_2.VPort
-				var err error
-				domain := new(nuage_v3_2.Domain)
-				domain.ID = args[1]
-				vports, err = domain.VPortsList(myconn)
-				if err != nil {
-					return "", err
-				}
-				for i, v := range vports {
-					jsonvport, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%#s\n", i, vports[i].Name, string(jsonvport))
-				}
-				return "Domain VPorts list -- done", err
+			row = append(row, value)
+		}
+		fmt.Fprintf(w, "%s\t\n", strings.Join(row, "\t"))
+	}
+	w.Flush()
+}
 
-			case "vminterfaces": // GET domains <ID> vminterfaces
-				// var vmis []nuage_v3_2.VMInterface
-				// var err error
-				var domain nuage_v3_2.Domain
-				domain.ID = args[1]
-				vmis, err := (&domain).VMInterfacesList(myconn)
-				if err != nil {
-					return "", err
-				}
-				for i, v := range vmis {
-					jsonvmi, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%#s\n", i, vmis[i].Name, string(jsonvmi))
-				}
-				return "Subnet VMInterfaces list -- done", err
+// Statistics as ASCII sparklines: One line per metric
+func printsparklines(st nuage_v3_2.Statistics) {
+	const levels = "_.,-=+*#"
 
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "METRIC\tMIN\tMAX\tLAST\t")
+	for _, m := range statsmetrics(st) {
+		values := st.Stats[m]
+		if len(values) == 0 {
+			fmt.Fprintf(w, "%s\t-\t-\t-\t\n", m)
+			continue
+		}
+		lo, hi := values[0], values[0]
+		for _, v := range values {
+			if v < lo {
+				lo = v
+			}
+			if v > hi {
+				hi = v
 			}
 		}
-	case "zonetemplates":
-		if len(args) < 2 {
-			return "Format:\n    GET zonetemplates <ID>", nil
+		line := make([]byte, len(values))
+		for i, v := range values {
+			level := 0
+			if hi > lo {
+				level = int((v - lo) / (hi - lo) * float64(len(levels)-1))
+			}
+			line[i] = levels[level]
 		}
+		fmt.Fprintf(w, "%s\t%.0f\t%.0f\t%.0f\t%s\n", m, lo, hi, values[len(values)-1], string(line))
+	}
+	w.Flush()
+}
 
-		switch len(args) {
-		case 2: // GET zonetemplates <ID>
-			zt := new(nuage_v3_2.Zonetemplate)
-			zt.ID = args[1]
-			err := zt.Get(myconn)
+////////
+//////// VirtualMachine resync: auxiliary functions
+////////
 
-			if err != nil {
-				return "", err
-			}
-			// JSON pretty-print the zone template
-			jsonzt, _ := json.MarshalIndent(zt, "", "\t")
-			fmt.Printf("\n ===> Zone Template: Name [%s] <=== \n%#s\n", zt.Name, string(jsonzt))
-			return "Zone Template Get -- done", err
+// Resync status, redrawn in place. Used as a progress callback for VirtualMachine "WaitResync"
+func printresyncprogress(r nuage_v3_2.VMResync) {
+	initiated := "-"
+	if r.LastTimeResyncInitiated > 0 {
+		initiated = time.Unix(0, r.LastTimeResyncInitiated*int64(time.Millisecond)).Format("15:04:05")
+	}
+	fmt.Printf("\r  Resync status [%-12s] initiated at [%s]", r.Status, initiated)
+}
+
+////////
+//////// Jobs: auxiliary functions
+////////
+
+// Job progress indicator, redrawn in place. Used as a progress callback for Job "Wait"
+func printjobprogress(job nuage_v3_2.Job) {
+	const width = 40
+	done := int(job.Progress * width)
+	if done > width {
+		done = width
+	}
+	fmt.Printf("\r  Job [%s] [%s%s] %3.0f%% %-8s", job.Command, strings.Repeat("#", done), strings.Repeat(".", width-done), 100*job.Progress, job.Status)
+}
+
+////////
+//////// Infrastructure: auxiliary functions
+////////
+
+// Print VRSs as a table
+func printvrss(vrss []nuage_v3_2.VRS) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tADDRESS\tPERSONALITY\tSTATUS\tUPTIME\tHYPERVISOR\tVMS\tVERSION\tID")
+	for _, v := range vrss {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", v.Name, v.Address, v.Personality, v.Status, uptime(v.Uptime), v.HypervisorName, v.NumberOfVirtualMachines, v.ProductVersion, v.ID)
+	}
+	w.Flush()
+}
+
+// Print all VirtualMachines grouped by hypervisor, with the VRS they are connected to
+func printhypervisors() (string, error) {
+	var vms nuage_v3_2.VirtualMachineslice
+	err := vms.List(myconn)
+	if err != nil {
+		return "", err
+	}
+
+	byhv := make(map[string][]nuage_v3_2.VirtualMachine)
+	var hvs []string
+	for _, vm := range vms {
+		if _, found := byhv[vm.HypervisorIP]; !found {
+			hvs = append(hvs, vm.HypervisorIP)
 		}
-	case "zones":
-		switch len(args) {
-		case 1: // GET zones
-			// Get list of zones with "nil" as parent domain -- i.e. global list of all zones
-			var zs nuage_v3_2.Zoneslice
-			err := zs.List(myconn, "")
-			if err != nil {
-				return "", err
+		byhv[vm.HypervisorIP] = append(byhv[vm.HypervisorIP], vm)
+	}
+	sort.Strings(hvs)
+
+	// VRS details, looked up once per VRS
+	vrss := make(map[string]*nuage_v3_2.VRS)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Println()
+	fmt.Fprintln(w, "HYPERVISOR\tVRS\tVRS STATUS\tVMS\tVM NAMES")
+	for _, hv := range hvs {
+		var names []string
+		vrsname, vrsstatus := "-", "-"
+		for _, vm := range byhv[hv] {
+			names = append(names, vm.Name)
+			if vm.VRSID == "" {
+				continue
 			}
-			// Yucky -- type cast from nuage_v3_2.Zoneslice to []nuage_v3_2.Zone
-			var zl []nuage_v3_2.Zone
-			zl = zs
-			for i, v := range zl {
-				jsonzone, _ := json.MarshalIndent(v, "", "\t")
-				fmt.Printf("\n ===> Zone nr [%d]: Name [%s] <=== \n%#s\n", i, zl[i].Name, string(jsonzone))
+			vrs, found := vrss[vm.VRSID]
+			if !found {
+				vrs = new(nuage_v3_2.VRS)
+				vrs.ID = vm.VRSID
+				if err := vrs.Get(myconn); err != nil {
+					log.Debugf("Unable to get VRS with ID: [%s] . Error: %s", vm.VRSID, err)
+				}
+				vrss[vm.VRSID] = vrs
+			}
+			if vrs.Name != "" {
+				vrsname, vrsstatus = vrs.Name, vrs.Status
+			}
+		}
+		if hv == "" {
+			hv = "<unknown>"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", hv, vrsname, vrsstatus, len(names), strings.Join(names, ", "))
+	}
+	w.Flush()
+
+	return fmt.Sprintf("Hypervisors list (%d hypervisors, %d VirtualMachines) -- done", len(hvs), len(vms)), nil
+}
+
+// Human readable uptime, from milliseconds
+func uptime(ms int64) string {
+	if ms <= 0 {
+		return "-"
+	}
+	return (time.Duration(ms/1000) * time.Second).String()
+}
+
+////////
+//////// Gateways: auxiliary functions
+////////
+
+// Print the Gateway -> Ports -> VLANs -> VPorts hierarchy of a Gateway
+func printgatewaytree(gwid string) (string, error) {
+	gw := new(nuage_v3_2.Gateway)
+	gw.ID = gwid
+	err := gw.Get(myconn)
+	if err != nil {
+		return "", err
+	}
+
+	var ports nuage_v3_2.Portslice
+	err = ports.List(myconn, gw.ID)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Printf("\n Gateway [%s] Personality [%s] System ID [%s] ID [%s]\n", gw.Name, gw.Personality, gw.SystemID, gw.ID)
+	for _, port := range ports {
+		fmt.Printf("   |-- Port [%s] Type [%s] Physical name [%s] VLAN range [%s] Status [%s] ID [%s]\n", port.Name, port.PortType, port.PhysicalName, port.VLANRange, port.Status, port.ID)
+
+		var vlans nuage_v3_2.VLANslice
+		err = vlans.List(myconn, port.ID)
+		if err != nil {
+			return "", err
+		}
+		for _, vlan := range vlans {
+			fmt.Printf("   |     |-- VLAN [%d] Status [%s] ID [%s]\n", vlan.Value, vlan.Status, vlan.ID)
+			if vlan.VPortID == "" {
+				continue
 			}
-			return "Zone list -- done", err
-		case 2: // GET zones <ID>
-			// Get a specific Zone ID
-			zone := new(nuage_v3_2.Zone)
-			zone.ID = args[1]
-			err := zone.Get(myconn)
+			vport := new(nuage_v3_2.VPort)
+			vport.ID = vlan.VPortID
+			err = vport.Get(myconn)
 			if err != nil {
 				return "", err
 			}
-			jsonzone, _ := json.MarshalIndent(zone, "", "\t")
-			fmt.Printf("\n ===> Zone Name [%s] <=== \n%#s\n", zone.Name, string(jsonzone))
-			return "Zone Get -- done", err
+			fmt.Printf("   |     |     |-- VPort [%s] Type [%s] Parent [%s %s] ID [%s]\n", vport.Name, vport.Type, vport.ParentType, vport.ParentID, vport.ID)
 		}
+	}
+	fmt.Println()
 
-	case "subnets":
-		switch len(args) {
-		case 1: // GET subnets
-			// Get list of subnets with "nil" as parent domain -- i.e. global list of all subnets
-			var ss nuage_v3_2.Subnetslice
-			err := ss.List(myconn, "")
-			if err != nil {
-				return "", err
-			}
-			// Yucky -- type cast from nuage_v3_2.Subnetslice to []nuage_v3_2.Subnet
-			var za []nuage_v3_2.Subnet
-			za = ss
-			for i, v := range za {
-				jsonsubnet, _ := json.MarshalIndent(v, "", "\t")
-				fmt.Printf("\n ===> Subnet nr [%d]: Name [%s] <=== \n%#s\n", i, za[i].Name, string(jsonsubnet))
-			}
-			return "Subnet list -- done", err
-		case 2: // GET subnets <ID>
-			// Get a specific Subnet ID
-			subnet := new(nuage_v3_2.Subnet)
-			subnet.ID = args[1]
-			err := subnet.Get(myconn)
-			if err != nil {
-				return "", err
-			}
-			jsonsubnet, _ := json.MarshalIndent(subnet, "", "\t")
-			fmt.Printf("\n ===> Subnet Name [%s] <=== \n%#s\n", subnet.Name, string(jsonsubnet))
-			return "Subnet Get -- done", err
+	return "Gateway tree -- done", nil
+}
 
-		case 3:
-			switch args[2] {
-			case "vports": // GET subnets <ID> vports
-				var vports []nuage_v3_2.VPort
-				var err error
-				subnet := new(nuage_v3_2.Subnet)
-				subnet.ID = args[1]
-				vports, err = subnet.VPortsList(myconn)
-				if err != nil {
-					return "", err
-				}
-				for i, v := range vports {
-					jsonvport, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> VPort nr [%d]: Name [%s] <=== \n%#s\n", i, vports[i].Name, string(jsonvport))
-				}
-				return "Subnet VPorts list -- done", err
-			case "vminterfaces": // GET subnets <ID> vminterfaces
-				var vmis []nuage_v3_2.VMInterface
-				var err error
-				var subnet nuage_v3_2.Subnet
-				subnet.ID = args[1]
-				vmis, err = (&subnet).VMInterfacesList(myconn)
-				if err != nil {
-					return "", err
-				}
-				for i, v := range vmis {
-					jsonvmi, _ := json.MarshalIndent(v, "", "\t")
-					fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%#s\n", i, vmis[i].Name, string(jsonvmi))
-				}
-				return "Subnet VMInterfaces list -- done", err
+////////
+//////// Multicast: auxiliary functions
+////////
 
-			}
+// Resolve a Multicast channel map ID to its name and address ranges. Nothing if the ID is empty. "label" qualifies it, e.g. "Send". Over API v3.2 (see "v3_2conn")
+func printchannelmap(label, cmid string) {
+	if cmid == "" {
+		return
+	}
+
+	if label != "" {
+		label = " (" + label + ")"
+	}
+
+	cm := new(nuage_v3_2.MulticastChannelMap)
+	cm.ID = cmid
+	if err := cm.Get(v3_2conn()); err != nil {
+		fmt.Printf("\n Multicast channel map%s: ID [%s] -- unable to resolve: %s\n", label, cmid, err)
+		return
+	}
+
+	fmt.Printf("\n Multicast channel map%s: Name [%s] ID [%s] Ranges: %s\n", label, cm.Name, cm.ID, channelmapranges(cm.ID))
+}
+
+// Resolve a Multicast list ID to its Channel maps, with their address ranges. Nothing if the ID is empty. "label" qualifies it, e.g. "Send". Over API v3.2 (see "v3_2conn")
+func printmulticastlist(label, mlid string) {
+	if mlid == "" {
+		return
+	}
+
+	if label != "" {
+		label = " (" + label + ")"
+	}
+
+	ml := new(nuage_v3_2.MulticastList)
+	ml.ID = mlid
+	if err := ml.Get(v3_2conn()); err != nil {
+		fmt.Printf("\n Multicast list%s: ID [%s] -- unable to resolve: %s\n", label, mlid, err)
+		return
+	}
+
+	if ml.Unlimited {
+		fmt.Printf("\n Multicast list%s: ID [%s] -- unlimited, all channels\n", label, ml.ID)
+		return
+	}
+
+	cms, err := ml.ChannelMapsList(v3_2conn())
+	if err != nil {
+		fmt.Printf("\n Multicast list%s: ID [%s] -- unable to obtain Channel maps: %s\n", label, ml.ID, err)
+		return
+	}
+
+	fmt.Printf("\n Multicast list%s: ID [%s] with [%d] Channel maps\n", label, ml.ID, len(cms))
+	for _, cm := range cms {
+		fmt.Printf("    Name [%s] ID [%s] Ranges: %s\n", cm.Name, cm.ID, channelmapranges(cm.ID))
+	}
+}
+
+// The address ranges of a Channel map, as "<Lowest> - <Highest>, ...". Over API v3.2 (see "v3_2conn")
+func channelmapranges(cmid string) string {
+	var mrs nuage_v3_2.MulticastRangeslice
+	if err := mrs.List(v3_2conn(), cmid); err != nil {
+		return "unknown (" + err.Error() + ")"
+	}
+
+	if len(mrs) == 0 {
+		return "none"
+	}
+
+	var ranges []string
+	for _, mr := range mrs {
+		ranges = append(ranges, mr.MinAddress+" - "+mr.MaxAddress)
+	}
+	return strings.Join(ranges, ", ")
+}
+
+////////
+//////// QoS: auxiliary functions
+////////
+
+// Summary of the rate limiting of a QoS, with units
+func printqos(q nuage_v3_2.QoS) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "\n\tPEAK RATE\tBURST\tCOMMITTED RATE\tACTIVE")
+	fmt.Fprintf(w, "VPort\t%s\t%s\t%s\t%t\n", withunit(q.Peak, "Mbps"), withunit(q.Burst, "KB"), withunit(q.CommittedInformationRate, "Mbps"), q.RateLimitingActive)
+	fmt.Fprintf(w, "FIP\t%s\t%s\t%s\t%t\n", withunit(q.FIPPeakInformationRate, "Mbps"), withunit(q.FIPPeakBurstSize, "KB"), withunit(q.FIPCommittedInformationRate, "Mbps"), q.FIPRateLimitingActive)
+	w.Flush()
+}
+
+func withunit(value, unit string) string {
+	if value == "" || value == "INFINITY" {
+		return value
+	}
+	return value + " " + unit
+}
+
+////////
+//////// DHCP options: auxiliary functions
+////////
+
+// Print DHCP options as a table, with their decoded values and where they are defined
+func printdhcpoptions(opts []nuage_v3_2.DHCPOption) {
+	names := make(map[int]string)
+	for name, code := range nuage_v3_2.DHCPOptionCodes {
+		names[code] = name
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CODE\tNAME\tVALUE\tDEFINED ON\tID")
+	for _, o := range opts {
+		code, values, err := o.Decode()
+		value := strings.Join(values, " ")
+		if err != nil {
+			value = "<invalid: " + o.Value + ">"
 		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", code, names[code], value, o.ParentType+" "+o.ParentID, o.ID)
+	}
+	w.Flush()
+}
 
-	case "vports": // GET vports <ID>
-		if len(args) != 2 {
-			return "Format:\n    GET vports <ID>", nil
+// Known DHCP option names, sorted
+func dhcpoptionnames() string {
+	var names []string
+	for name := range nuage_v3_2.DHCPOptionCodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+////////
+//////// Floating IPs: auxiliary functions
+////////
+
+// Print the Floating IP quota usage of the Enterprise a given Domain belongs to
+func printfipquota(domainid string) error {
+	domain := new(nuage_v3_2.Domain)
+	domain.ID = domainid
+	err := domain.Get(myconn)
+	if err != nil {
+		return err
+	}
+
+	org := new(nuage_v3_2.Enterprise)
+	org.ID = domain.ParentID
+	err = org.Get(myconn)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n Enterprise [%s] Floating IPs: used [%d] out of quota [%d]\n\n", org.Name, org.FloatingIPsUsed, org.FloatingIPsQuota)
+	return nil
+}
+
+////////
+//////// ACL rules: auxiliary functions for the GET / CREATE wrappers
+////////
+
+// Single ACL entry, regardless of direction, as displayed by "GET domains <ID> aclrules"
+type aclrule struct {
+	Direction        string
+	Template         string
+	TemplatePriority int
+	PriorityType     string
+	nuage_v3_2.IngressACLEntry
+}
+
+// Order in which the VSD evaluates ACL templates: "TOP" ones first, "BOTTOM" ones last, by priority in between
+func priotyperank(prioritytype string) int {
+	switch prioritytype {
+	case "TOP":
+		return 0
+	case "BOTTOM":
+		return 2
+	default:
+		return 1
+	}
+}
+
+type aclrules []aclrule
+
+func (r aclrules) Len() int      { return len(r) }
+func (r aclrules) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r aclrules) Less(i, j int) bool {
+	if r[i].Direction != r[j].Direction {
+		return r[i].Direction < r[j].Direction
+	}
+	if priotyperank(r[i].PriorityType) != priotyperank(r[j].PriorityType) {
+		return priotyperank(r[i].PriorityType) < priotyperank(r[j].PriorityType)
+	}
+	if r[i].TemplatePriority != r[j].TemplatePriority {
+		return r[i].TemplatePriority < r[j].TemplatePriority
+	}
+	return r[i].Priority < r[j].Priority
+}
+
+// Collect the effective (i.e. active, non-draft) ingress and egress ACL entries for a given parent (domain, domain template...) and print them in the order they are evaluated.
+func printaclrules(parenttype, parentid string) (string, error) {
+	var rules aclrules
+
+	var iacls nuage_v3_2.IngressACLTemplateslice
+	err := iacls.List(myconn, parenttype, parentid)
+	if err != nil {
+		return "", err
+	}
+	for _, iacl := range iacls {
+		if !iacl.Active || iacl.PolicyState == "DRAFT" {
+			continue
 		}
-		vport := new(nuage_v3_2.VPort)
-		vport.ID = args[1]
-		err := vport.Get(myconn)
+		var entries nuage_v3_2.IngressACLEntryslice
+		err = entries.List(myconn, iacl.ID)
 		if err != nil {
 			return "", err
 		}
-		jsonvport, _ := json.MarshalIndent(vport, "", "\t")
-		fmt.Printf("\n ===> VPort Name [%s] <=== \n%#s\n", vport.Name, string(jsonvport))
-		return "VPort Get -- done", err
+		for _, entry := range entries {
+			rules = append(rules, aclrule{"ingress", iacl.Name, iacl.Priority, iacl.PriorityType, entry})
+		}
+	}
 
-	case "vminterfaces":
-		switch len(args) {
-		case 1: // GET vminterfaces
-			var vmis nuage_v3_2.VMInterfaceslice
-			err := vmis.List(myconn)
-			if err != nil {
-				return "", err
-			}
-			// Yucky -- type cast from nuage_v3_2.VMInterfaceslice to []nuage_v3_2.VMInterface
-			var vmia []nuage_v3_2.VMInterface
-			vmia = vmis
-			for i, v := range vmia {
-				jsonvminterface, _ := json.MarshalIndent(v, "", "\t")
-				fmt.Printf("\n ===> VMInterface nr [%d]: Name [%s] <=== \n%#s\n", i, vmia[i].Name, string(jsonvminterface))
-			}
-			return "VMinterfaces list -- done", err
+	var eacls nuage_v3_2.EgressACLTemplateslice
+	err = eacls.List(myconn, parenttype, parentid)
+	if err != nil {
+		return "", err
+	}
+	for _, eacl := range eacls {
+		if !eacl.Active || eacl.PolicyState == "DRAFT" {
+			continue
+		}
+		var entries nuage_v3_2.EgressACLEntryslice
+		err = entries.List(myconn, eacl.ID)
+		if err != nil {
+			return "", err
+		}
+		for _, entry := range entries {
+			// Ingress and Egress ACL entries have identical fields
+			rules = append(rules, aclrule{"egress", eacl.Name, eacl.Priority, eacl.PriorityType, nuage_v3_2.IngressACLEntry(entry)})
+		}
+	}
 
-		case 2: // GET vminterfaces <ID>
-			var vminterface nuage_v3_2.VMInterface
-			vminterface.ID = args[1]
-			err := (&vminterface).Get(myconn)
+	sort.Sort(rules)
+
+	fmt.Printf("\n ######## Effective ACL rules for %s ID: [%s] ########\n\n", parenttype, parentid)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "DIRECTION\tTEMPLATE\tPRIORITY\tACTION\tPROTOCOL\tSRC PORT\tDST PORT\tNETWORK\tLOCATION\tSTATEFUL\tID")
+	for _, r := range rules {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%t\t%s\n", r.Direction, r.Template, r.Priority, r.Action, r.Protocol, r.SourcePort, r.DestinationPort, r.NetworkType+" "+r.NetworkID, r.LocationType+" "+r.LocationID, r.Stateful, r.ID)
+	}
+	w.Flush()
+
+	return fmt.Sprintf("ACL rules list (%d rules) -- done", len(rules)), nil
+}
+
+// Build an ACL entry from "CREATE ingressaclentry|egressaclentry <ACL template ID> <Priority> <FORWARD|DROP> [ key=value ... ]"
+func aclentryfromargs(args []string) (nuage_v3_2.IngressACLEntry, error) {
+	var entry nuage_v3_2.IngressACLEntry
+
+	entry.ParentID = args[1]
+
+	priority, err := strconv.Atoi(args[2])
+	if err != nil {
+		return entry, fmt.Errorf("Invalid priority: [%s]", args[2])
+	}
+	entry.Priority = priority
+	entry.Action = strings.ToUpper(args[3])
+
+	opts, err := parseopts(args[4:])
+	if err != nil {
+		return entry, err
+	}
+
+	for k, v := range opts {
+		switch k {
+		case "protocol":
+			entry.Protocol = toprotocol(v)
+		case "srcport":
+			entry.SourcePort = v
+		case "dstport":
+			entry.DestinationPort = v
+		case "networktype":
+			entry.NetworkType = strings.ToUpper(v)
+		case "networkid":
+			entry.NetworkID = v
+		case "locationtype":
+			entry.LocationType = strings.ToUpper(v)
+		case "locationid":
+			entry.LocationID = v
+		case "ethertype":
+			entry.EtherType = v
+		case "dscp":
+			entry.DSCP = v
+		case "stateful":
+			entry.Stateful, err = strconv.ParseBool(v)
 			if err != nil {
-				return "", err
+				return entry, fmt.Errorf("Invalid value for stateful: [%s]", v)
 			}
-			jsonvminterface, _ := json.MarshalIndent(vminterface, "", "\t")
-			fmt.Printf("\n ===> VMinterface Name [%s] <=== \n%#s\n", vminterface.Name, string(jsonvminterface))
-			return "VMinterface Get -- done", err
+		case "description":
+			entry.Description = v
+		default:
+			return entry, fmt.Errorf("Unknown option: [%s]", k)
+		}
+	}
+
+	return entry, nil
+}
+
+// Build an Ingress advanced forwarding entry from "CREATE ingressadvfwdentry <Template ID> <Priority> <FORWARD|DROP|REDIRECT> [ key=value ... ]".
+// The options are those of ACL entries (see "aclentryfromargs"), less "stateful", plus "redirecttarget"
+func advfwdentryfromargs(args []string) (nuage_v3_2.IngressAdvFwdEntry, error) {
+	var entry nuage_v3_2.IngressAdvFwdEntry
+
+	var aclargs []string
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "redirecttarget="):
+			entry.RedirectVPortTagID = strings.TrimPrefix(arg, "redirecttarget=")
+		case strings.HasPrefix(arg, "stateful="):
+			return entry, fmt.Errorf("Unknown option: [stateful]")
+		default:
+			aclargs = append(aclargs, arg)
 		}
+	}
 
-	case "vms":
-		switch len(args) {
-		case 1: // GET vms
-			var vms nuage_v3_2.VirtualMachineslice
-			err := vms.List(myconn)
-			if err != nil {
-				return "", err
-			}
-			// Yucky -- type cast from nuage_v3_2.VirtualMachineslice to []nuage_v3_2.VirtualMachine
-			var vma []nuage_v3_2.VirtualMachine
-			vma = vms
-			for i, v := range vma {
-				jsonvm, _ := json.MarshalIndent(v, "", "\t")
-				fmt.Printf("\n ===> VirtualMachine nr [%d]: Name [%s] <=== \n%#s\n", i, vma[i].Name, string(jsonvm))
-			}
-			return "VirtualMachine list -- done", err
+	if len(aclargs) < 4 {
+		return entry, fmt.Errorf("Missing template ID, priority or action")
+	}
 
-		case 2: // GET vms <ID>
-			var vm nuage_v3_2.VirtualMachine
-			vm.ID = args[1]
-			err := (&vm).Get(myconn)
-			if err != nil {
-				return "", err
-			}
-			jsonvm, _ := json.MarshalIndent(vm, "", "\t")
-			fmt.Printf("\n ===> VirtualMachine Name [%s] <=== \n%#s\n", vm.Name, string(jsonvm))
-			return "Virtual Machine Get -- done", err
+	acl, err := aclentryfromargs(aclargs)
+	if err != nil {
+		return entry, err
+	}
+
+	entry.ParentID = acl.ParentID
+	entry.Priority = acl.Priority
+	entry.Action = acl.Action
+	entry.Protocol = acl.Protocol
+	entry.SourcePort = acl.SourcePort
+	entry.DestinationPort = acl.DestinationPort
+	entry.NetworkType = acl.NetworkType
+	entry.NetworkID = acl.NetworkID
+	entry.LocationType = acl.LocationType
+	entry.LocationID = acl.LocationID
+	entry.EtherType = acl.EtherType
+	entry.DSCP = acl.DSCP
+	entry.Description = acl.Description
+
+	return entry, nil
+}
+
+////////
+//////// Auxiliary functions
+////////
+
+// Parse "key=value" options (e.g. trailing arguments of CREATE)
+func parseopts(args []string) (map[string]string, error) {
+	opts := make(map[string]string)
+	for _, arg := range args {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("Invalid option: [%s]. Format: key=value", arg)
 		}
+		opts[kv[0]] = kv[1]
+	}
+	return opts, nil
+}
 
-	default:
-		// Unknown entity request
-		break
+// Prompt for a new password, twice, without echoing it
+func readpassword() (string, error) {
+	fmt.Print("  Enter the new password> ")
+	password := string(gopass.GetPasswd())
+	if password == "" {
+		return "", fmt.Errorf("Empty password")
 	}
-	return "Don't know how to process Nuage API entity: " + strings.Join(args, " "), nil
+	fmt.Print("  Enter the new password again> ")
+	if string(gopass.GetPasswd()) != password {
+		return "", fmt.Errorf("Passwords do not match")
+	}
+	return password, nil
+}
+
+// Address + netmask in CIDR notation, e.g. "192.0.2.0/24". Returns the inputs unchanged if they can't be parsed
+func tocidr(address, netmask string) string {
+	ip := net.ParseIP(address)
+	nm := net.ParseIP(netmask).To4()
+	if ip == nil || nm == nil {
+		return address + "/" + netmask
+	}
+	ones, _ := net.IPMask(nm).Size()
+	return ip.String() + "/" + strconv.Itoa(ones)
+}
+
+// Accept the usual protocol names as well as IANA protocol numbers
+func toprotocol(v string) string {
+	switch strings.ToLower(v) {
+	case "tcp":
+		return "6"
+	case "udp":
+		return "17"
+	case "icmp":
+		return "1"
+	case "any":
+		return "ANY"
+	}
+	return v
 }
 
 ////////
@@ -748,6 +5015,19 @@ func Get(args ...string) (string, error) {
 // Establish Nuage API connection. Wrapper around Nuage.Connect()
 func makeconn(args ...string) (string, error) {
 
+	// No API version set -- negotiate one with the VSD
+	if myconn.Apivers == "" {
+		err := myconn.ProbeVersions()
+		if err != nil {
+			fmt.Printf("Nuage API version negotiation failed: ")
+			return "", err
+		}
+		err = myconn.NegotiateApivers(apiversions...)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	err := myconn.Connect(org, user, pass)
 
 	if err != nil {
@@ -807,16 +5087,37 @@ func setconn(args ...string) (string, error) {
 		}
 	}
 
-	// Get password
+	// Get password. Not echoed
 	fmt.Print("  Enter your password. Leave empty if default > ")
-	_, err = fmt.Scanln(&pass)
+	if p := string(gopass.GetPasswd()); p != "" {
+		pass = p
+	}
+
+	// Get Nuage API version. Empty means it is negotiated with the VSD upon "makeconn"
+	var apivers string
+	current := myconn.Apivers
+	if current == "" {
+		current = "auto"
+	}
+	fmt.Printf("  Enter the Nuage API version: %s or \"auto\" (negotiated with the VSD). Leave empty if default [%s] > ", strings.Join(apiversions, ", "), current)
+	_, err = fmt.Scanln(&apivers)
 	if err != nil {
 		if err.Error() != "unexpected newline" {
 			return "Error: ", err
 		}
 	}
 
-	// TBD: Insert code for changing the Nuage API version here. Currently only 3_2 (hardcoded)
+	switch apivers {
+	case "":
+		// Keep the current one
+	case "auto":
+		myconn.Apivers = ""
+	case "v3_2", "v4_0":
+		myconn.Apivers = apivers
+	default:
+		return "'" + apivers + "'" + " is not a supported Nuage API version", nil
+	}
+
 	return "", nil
 
 }